@@ -0,0 +1,74 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"bitbucket.org/accezz-io/sac-operator/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccessPolicySpec defines the desired state of AccessPolicy
+type AccessPolicySpec struct {
+
+	// The SecureAccessCloudTenant this policy is created in (default is the operator default tenant)
+	// +optional
+	TenantRef string `json:"tenant_ref,omitempty"`
+
+	// The protocol of the applications this policy applies to. Valid values are: HTTP, SSH, RDP, TCP
+	// (default is HTTP)
+	// +kubebuilder:validation:Enum=HTTP;SSH;RDP;TCP
+	// +kubebuilder:default=HTTP
+	TargetProtocol model.ApplicationType `json:"target_protocol,omitempty"`
+
+	// +kubebuilder:default=true
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The users and groups granted access by this policy.
+	// +optional
+	DirectoryEntities []DirectoryEntity `json:"directory_entities,omitempty"`
+
+	// The conditions (e.g. location, IP address) the access is restricted to.
+	// +optional
+	FilterConditions []FilterCondition `json:"filter_conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// AccessPolicy is the Schema for the accesspolicies API
+type AccessPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessPolicySpec   `json:"spec,omitempty"`
+	Status CommonPolicyStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AccessPolicyList contains a list of AccessPolicy
+type AccessPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AccessPolicy{}, &AccessPolicyList{})
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"bitbucket.org/accezz-io/sac-operator/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ActivityPolicySpec defines the desired state of ActivityPolicy
+type ActivityPolicySpec struct {
+
+	// The SecureAccessCloudTenant this policy is created in (default is the operator default tenant)
+	// +optional
+	TenantRef string `json:"tenant_ref,omitempty"`
+
+	// The protocol of the applications this policy applies to. Valid values are: HTTP, SSH
+	// (default is HTTP)
+	// +kubebuilder:validation:Enum=HTTP;SSH
+	// +kubebuilder:default=HTTP
+	TargetProtocol model.ApplicationType `json:"target_protocol,omitempty"`
+
+	// +kubebuilder:default=true
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The users and groups whose activity is governed by this policy.
+	// +optional
+	DirectoryEntities []DirectoryEntity `json:"directory_entities,omitempty"`
+
+	// The rules applied to the activity of the users, evaluated by order.
+	// +kubebuilder:validation:MinItems=1
+	Rules []ActivityRule `json:"rules"`
+}
+
+type ActivityRule struct {
+
+	// The action taken when the rule matches (e.g. ALLOW, BLOCK, BLOCK_USER, DISCONNECT_USER, RECORD)
+	// +kubebuilder:validation:Required
+	Action string `json:"action"`
+
+	// The conditions the rule matches on (e.g. the user location or device).
+	// +optional
+	Conditions []ActivityRuleCondition `json:"conditions,omitempty"`
+
+	// The activity the rule applies to (e.g. HTTP methods and paths, SSH commands).
+	// +optional
+	Targets []ActivityRuleTarget `json:"targets,omitempty"`
+}
+
+type ActivityRuleCondition struct {
+
+	// The condition type (e.g. IP_UTILS_COUNTRY, MANAGED_DEVICE)
+	// +kubebuilder:validation:Required
+	Type string `json:"type"`
+
+	// The condition arguments (e.g. countries: [US, IL])
+	// +optional
+	Arguments map[string][]string `json:"arguments,omitempty"`
+}
+
+type ActivityRuleTarget struct {
+
+	// The target type (e.g. METHOD, URL, FILE_DOWNLOADED)
+	// +kubebuilder:validation:Required
+	Type string `json:"type"`
+
+	// The target arguments (e.g. methods: [POST, DELETE])
+	// +optional
+	Arguments map[string][]string `json:"arguments,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ActivityPolicy is the Schema for the activitypolicies API
+type ActivityPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ActivityPolicySpec `json:"spec,omitempty"`
+	Status CommonPolicyStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ActivityPolicyList contains a list of ActivityPolicy
+type ActivityPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ActivityPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ActivityPolicy{}, &ActivityPolicyList{})
+}
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"bitbucket.org/accezz-io/sac-operator/model"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -14,11 +15,13 @@ type Service struct {
 	// +optional
 	Namespace string `json:"namespace"`
 
-	// The port name that will be exposed by this application.
+	// The port that will be exposed by this application: the name or the number of a port of the service, or of its
+	// target port. The port is resolved to the number of the matching service port.
 	// +kubebuilder:validation:Required
 	Port string `json:"port"`
 
-	// Protocol Schema (default is based on port and application type)
+	// Protocol Schema (default is based on the appProtocol or the name of the service port, then on the port number
+	// and the application type)
 	// +optional
 	Schema string `json:"schema,omitempty"`
 }
@@ -28,6 +31,10 @@ type CommonApplicationParams struct {
 	// The site to bind this application. The site should be an existing Site in your Secure Access Cloud tenant
 	SiteName string `json:"site"`
 
+	// The SecureAccessCloudTenant this application is created in (default is the operator default tenant)
+	// +optional
+	TenantRef string `json:"tenant_ref,omitempty"`
+
 	// A list of access-policies names to enforce on this application.
 	// +optional
 	AccessPoliciesNames []string `json:"access_policies,omitempty"`
@@ -44,6 +51,13 @@ type CommonApplicationParams struct {
 
 	// +kubebuilder:default=true
 	Enabled *bool `json:"enabled,omitempty"`
+
+	// What to do when the application was modified or deleted in Secure-Access-Cloud outside the cluster. Valid values
+	// are: Correct, Report (default is Correct)
+	// +kubebuilder:validation:Enum=Correct;Report
+	// +kubebuilder:default=Correct
+	// +optional
+	DriftPolicy model.DriftPolicy `json:"drift_policy,omitempty"`
 }
 
 type CommonApplicationStatus struct {
@@ -57,4 +71,23 @@ type CommonApplicationStatus struct {
 	// Information when was the last time the application was successfully modified by the operator.
 	// +optional
 	ModifiedOn metav1.Time `json:"modifiedOn,omitempty"`
+
+	// The generation of the application spec the status was computed from.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// The internal address of the application, with the named port and the schema resolved from the exposed Service.
+	// +optional
+	InternalAddress string `json:"internalAddress,omitempty"`
+
+	// The fields of the application which drifted in Secure-Access-Cloud and were not corrected, according to the
+	// drift policy.
+	// +optional
+	Drift []string `json:"drift,omitempty"`
+
+	// The latest observations of the application state: Ready, Synced, SiteBound, PoliciesBound and Degraded.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
@@ -0,0 +1,64 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type DirectoryEntity struct {
+
+	// The identifier of the user or group in its identity provider
+	// +kubebuilder:validation:Required
+	IdentifierInProvider string `json:"identifier_in_provider"`
+
+	// The identity provider id in Secure-Access-Cloud
+	// +kubebuilder:validation:Required
+	IdentityProviderID string `json:"identity_provider_id"`
+
+	// The identity provider type (e.g. local, okta, azure)
+	// +kubebuilder:validation:Required
+	IdentityProviderType string `json:"identity_provider_type"`
+
+	// The directory entity type. Valid values are: User, Group
+	// +kubebuilder:validation:Enum=User;Group
+	Type string `json:"type"`
+
+	// +optional
+	DisplayName string `json:"display_name,omitempty"`
+}
+
+type FilterCondition struct {
+
+	// The condition type (e.g. IP_UTILS_COUNTRY, IP_UTILS_IP_ADDRESS, MANAGED_DEVICE)
+	// +kubebuilder:validation:Required
+	Type string `json:"type"`
+
+	// Whether the condition should match (true) or should not match (false) the given arguments
+	// +kubebuilder:default=true
+	// +optional
+	Positive *bool `json:"positive,omitempty"`
+
+	// The condition arguments (e.g. countries: [US, IL])
+	// +optional
+	Arguments map[string][]string `json:"arguments,omitempty"`
+}
+
+type CommonPolicyStatus struct {
+
+	// The policy-id in Secure-Access-Cloud
+	// +optional
+	Id string `json:"id,omitempty"`
+
+	// Information when was the last time the policy was successfully modified by the operator.
+	// +optional
+	ModifiedOn metav1.Time `json:"modifiedOn,omitempty"`
+
+	// The generation of the policy spec the status was computed from.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// The latest observations of the policy state: Ready, Synced and Degraded.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
@@ -0,0 +1,36 @@
+package v1
+
+// The condition types reported in the status of the Sites and the applications.
+const (
+	// ConditionReady is True when the object was reconciled in Secure-Access-Cloud without errors.
+	ConditionReady = "Ready"
+	// ConditionSynced is True when the object exists in Secure-Access-Cloud and matches its spec.
+	ConditionSynced = "Synced"
+	// ConditionSiteBound is True when the application is bound to its site.
+	ConditionSiteBound = "SiteBound"
+	// ConditionPoliciesBound is True when the application is bound to its policies.
+	ConditionPoliciesBound = "PoliciesBound"
+	// ConditionConnectorsAvailable is True when the site has the desired number of healthy connectors.
+	ConditionConnectorsAvailable = "ConnectorsAvailable"
+	// ConditionDegraded is True when the last reconcile failed.
+	ConditionDegraded = "Degraded"
+	// ConditionServiceAvailable is True when the Service exposed by the application exists and has the exposed port.
+	ConditionServiceAvailable = "ServiceAvailable"
+)
+
+// The reasons of the conditions reported in the status of the Sites and the applications.
+const (
+	ReasonReconciled            = "Reconciled"
+	ReasonReconcileFailed       = "ReconcileFailed"
+	ReasonUnrecoverableError    = "UnrecoverableError"
+	ReasonSiteBound             = "SiteBound"
+	ReasonSiteNotBound          = "SiteNotBound"
+	ReasonPoliciesBound         = "PoliciesBound"
+	ReasonPoliciesNotBound      = "PoliciesNotBound"
+	ReasonConnectorsAvailable   = "ConnectorsAvailable"
+	ReasonConnectorsUnavailable = "ConnectorsUnavailable"
+	ReasonDriftDetected         = "DriftDetected"
+	ReasonServiceAvailable      = "ServiceAvailable"
+	ReasonServiceNotFound       = "ServiceNotFound"
+	ReasonServicePortNotFound   = "ServicePortNotFound"
+)
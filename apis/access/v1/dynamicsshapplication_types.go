@@ -0,0 +1,74 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DynamicSshApplicationSpec defines the desired state of DynamicSshApplication
+type DynamicSshApplicationSpec struct {
+	CommonApplicationParams `json:",inline"`
+
+	// Label selector of the pods, in the application's namespace, exposed by this application.
+	// +kubebuilder:validation:Required
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// The SSH port exposed by the selected pods.
+	// +kubebuilder:default="22"
+	// +optional
+	Port string `json:"port,omitempty"`
+
+	// +optional
+	*SshSettings `json:"ssh_settings,omitempty"`
+}
+
+// DynamicSshApplicationStatus defines the observed state of DynamicSshApplication
+type DynamicSshApplicationStatus struct {
+	CommonApplicationStatus `json:",inline"`
+
+	// The pods currently exposed by the application, as <pod-name>/<pod-ip>.
+	// +optional
+	Targets []string `json:"targets,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// DynamicSshApplication is the Schema for the dynamicsshapplications API
+type DynamicSshApplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DynamicSshApplicationSpec   `json:"spec,omitempty"`
+	Status DynamicSshApplicationStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// DynamicSshApplicationList contains a list of DynamicSshApplication
+type DynamicSshApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DynamicSshApplication `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DynamicSshApplication{}, &DynamicSshApplicationList{})
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	accessv2 "bitbucket.org/accezz-io/sac-operator/apis/access/v2"
+	"bitbucket.org/accezz-io/sac-operator/model"
+	"bitbucket.org/accezz-io/sac-operator/utils"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+const (
+	// CustomSSLCertificateAnnotation keeps the inline custom_ssl_certificate of a v1 HttpApplication, which has no v2
+	// field, so the application is converted back to v1 unchanged.
+	CustomSSLCertificateAnnotation = "access.secure-access-cloud.symantec.com/v1-custom-ssl-certificate"
+	// WildcardPrivateKeyAnnotation keeps the inline wildcard_private_key of a v1 HttpApplication, which has no v2
+	// field, so the application is converted back to v1 unchanged.
+	WildcardPrivateKeyAnnotation = "access.secure-access-cloud.symantec.com/v1-wildcard-private-key"
+)
+
+// ConvertTo converts the application to the v2 HttpApplication. The conversion never fails: the inline
+// custom_ssl_certificate and wildcard_private_key of the applications created before v2, which are no longer accepted
+// by the validating webhook, are kept in annotations.
+func (src *HttpApplication) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*accessv2.HttpApplication)
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = accessv2.HttpApplicationSpec{
+		Site:      src.Spec.SiteName,
+		TenantRef: src.Spec.TenantRef,
+		Target: accessv2.HttpTarget{
+			Service: accessv2.ServiceReference{
+				Name:      src.Spec.Service.Name,
+				Namespace: src.Spec.Service.Namespace,
+				Port:      src.Spec.Service.Port,
+				Schema:    src.Spec.Service.Schema,
+			},
+		},
+		Exposure: accessv2.HttpExposure{
+			Type: subTypeToExposureType(utils.GetApplicationSubTypeOrDefault(src.Spec.SubType, model.DefaultSubType)),
+		},
+		Policies: accessv2.Policies{
+			Access:   src.Spec.AccessPoliciesNames,
+			Activity: src.Spec.ActivityPoliciesNames,
+		},
+		Visible:             src.Spec.IsVisible,
+		NotificationEnabled: src.Spec.IsNotificationEnabled,
+		Enabled:             src.Spec.Enabled,
+		DriftPolicy:         src.Spec.DriftPolicy,
+	}
+
+	if settings := src.Spec.HttpConnectionSettings; settings != nil {
+		dst.Spec.Target.RootPath = settings.CustomRootPath
+		dst.Spec.Target.HealthURL = settings.HealthUrl
+		dst.Spec.Target.HealthMethod = settings.HealthMethod
+		dst.Spec.Exposure.SubDomain = settings.SubDomain
+		dst.Spec.Exposure.ExternalAddress = settings.CustomExternalAddress
+		if settings.CustomSSLCertificateSecretName != "" {
+			dst.Spec.Exposure.CertificateSecretRef = &corev1.LocalObjectReference{Name: settings.CustomSSLCertificateSecretName}
+		}
+		if settings.CustomSSLCertificate != "" || settings.WildcardPrivateKey != "" {
+			dst.Annotations = copyAnnotations(src.Annotations)
+			setAnnotation(dst.Annotations, CustomSSLCertificateAnnotation, settings.CustomSSLCertificate)
+			setAnnotation(dst.Annotations, WildcardPrivateKeyAnnotation, settings.WildcardPrivateKey)
+		}
+	}
+
+	if settings := src.Spec.HttpLinkTranslationSettings; settings != nil {
+		dst.Spec.LinkTranslation = &accessv2.HttpLinkTranslation{
+			DefaultContentRewriteRulesEnabled: settings.IsDefaultContentRewriteRulesEnabled,
+			DefaultHeaderRewriteRulesEnabled:  settings.IsDefaultHeaderRewriteRulesEnabled,
+			UseExternalAddressForHostAndSni:   settings.UseExternalAddressForHostAndSni,
+			LinkedApplications:                settings.LinkedApplications,
+		}
+	}
+
+	if settings := src.Spec.HttpRequestCustomizationSettings; settings != nil {
+		dst.Spec.RequestCustomization = &accessv2.HttpRequestCustomization{Headers: settings.HeaderCustomization}
+	}
+
+	dst.Status = accessv2.HttpApplicationStatus{
+		ID:                 src.Status.Id,
+		ModifiedOn:         src.Status.ModifiedOn,
+		ObservedGeneration: src.Status.ObservedGeneration,
+		InternalAddress:    src.Status.InternalAddress,
+		Drift:              src.Status.Drift,
+		Conditions:         src.Status.Conditions,
+	}
+
+	return nil
+}
+
+// ConvertFrom converts the v2 HttpApplication to this version.
+func (dst *HttpApplication) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*accessv2.HttpApplication)
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = HttpApplicationSpec{
+		CommonApplicationParams: CommonApplicationParams{
+			SiteName:              src.Spec.Site,
+			TenantRef:             src.Spec.TenantRef,
+			AccessPoliciesNames:   src.Spec.Policies.Access,
+			ActivityPoliciesNames: src.Spec.Policies.Activity,
+			IsVisible:             src.Spec.Visible,
+			IsNotificationEnabled: src.Spec.NotificationEnabled,
+			Enabled:               src.Spec.Enabled,
+			DriftPolicy:           src.Spec.DriftPolicy,
+		},
+		Service: Service{
+			Name:      src.Spec.Target.Service.Name,
+			Namespace: src.Spec.Target.Service.Namespace,
+			Port:      src.Spec.Target.Service.Port,
+			Schema:    src.Spec.Target.Service.Schema,
+		},
+	}
+	dst.Spec.SubType = exposureTypeToSubType(src.Spec.Exposure.Type)
+
+	settings := HttpConnectionSettings{
+		SubDomain:             src.Spec.Exposure.SubDomain,
+		CustomExternalAddress: src.Spec.Exposure.ExternalAddress,
+		CustomRootPath:        src.Spec.Target.RootPath,
+		HealthUrl:             src.Spec.Target.HealthURL,
+		HealthMethod:          src.Spec.Target.HealthMethod,
+		CustomSSLCertificate:  src.Annotations[CustomSSLCertificateAnnotation],
+		WildcardPrivateKey:    src.Annotations[WildcardPrivateKeyAnnotation],
+	}
+	if src.Spec.Exposure.CertificateSecretRef != nil {
+		settings.CustomSSLCertificateSecretName = src.Spec.Exposure.CertificateSecretRef.Name
+	}
+	if settings != (HttpConnectionSettings{}) {
+		dst.Spec.HttpConnectionSettings = &settings
+	}
+	if settings.CustomSSLCertificate != "" || settings.WildcardPrivateKey != "" {
+		dst.Annotations = copyAnnotations(src.Annotations)
+		delete(dst.Annotations, CustomSSLCertificateAnnotation)
+		delete(dst.Annotations, WildcardPrivateKeyAnnotation)
+		if len(dst.Annotations) == 0 {
+			dst.Annotations = nil
+		}
+	}
+
+	if linkTranslation := src.Spec.LinkTranslation; linkTranslation != nil {
+		dst.Spec.HttpLinkTranslationSettings = &HttpLinkTranslationSettings{
+			IsDefaultContentRewriteRulesEnabled: linkTranslation.DefaultContentRewriteRulesEnabled,
+			IsDefaultHeaderRewriteRulesEnabled:  linkTranslation.DefaultHeaderRewriteRulesEnabled,
+			UseExternalAddressForHostAndSni:     linkTranslation.UseExternalAddressForHostAndSni,
+			LinkedApplications:                  linkTranslation.LinkedApplications,
+		}
+	}
+
+	if requestCustomization := src.Spec.RequestCustomization; requestCustomization != nil {
+		dst.Spec.HttpRequestCustomizationSettings = &HttpRequestCustomizationSettings{
+			HeaderCustomization: requestCustomization.Headers,
+		}
+	}
+
+	dst.Status = CommonApplicationStatus{
+		Id:                 src.Status.ID,
+		ModifiedOn:         src.Status.ModifiedOn,
+		ObservedGeneration: src.Status.ObservedGeneration,
+		InternalAddress:    src.Status.InternalAddress,
+		Drift:              src.Status.Drift,
+		Conditions:         src.Status.Conditions,
+	}
+
+	return nil
+}
+
+func subTypeToExposureType(subType model.ApplicationSubType) accessv2.HttpExposureType {
+	switch subType {
+	case model.CustomDomain:
+		return accessv2.CustomDomainExposure
+	case model.WildCardDomain:
+		return accessv2.WildcardDomainExposure
+	default:
+		return accessv2.TenantDomainExposure
+	}
+}
+
+func exposureTypeToSubType(exposureType accessv2.HttpExposureType) model.ApplicationSubType {
+	switch exposureType {
+	case accessv2.CustomDomainExposure:
+		return model.CustomDomain
+	case accessv2.WildcardDomainExposure:
+		return model.WildCardDomain
+	default:
+		return model.DefaultSubType
+	}
+}
+
+func copyAnnotations(annotations map[string]string) map[string]string {
+	copied := make(map[string]string, len(annotations)+2)
+	for key, value := range annotations {
+		copied[key] = value
+	}
+	return copied
+}
+
+func setAnnotation(annotations map[string]string, key, value string) {
+	if value != "" {
+		annotations[key] = value
+	}
+}
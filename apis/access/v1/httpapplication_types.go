@@ -49,6 +49,8 @@ type HttpApplicationSpec struct {
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // HttpApplication is the Schema for the httpapplications API
 type HttpApplication struct {
@@ -87,6 +89,10 @@ type HttpConnectionSettings struct {
 	CustomSSLCertificate string `json:"custom_ssl_certificate,omitempty"`
 	// +kubebuilder:validation:Optional
 	WildcardPrivateKey string `json:"wildcard_private_key,omitempty"`
+	// The name of a kubernetes.io/tls Secret in the application namespace holding the certificate and the private key
+	// of the custom or wildcard domain, instead of custom_ssl_certificate and wildcard_private_key.
+	// +kubebuilder:validation:Optional
+	CustomSSLCertificateSecretName string `json:"custom_ssl_certificate_secret_name,omitempty"`
 }
 
 type HttpLinkTranslationSettings struct {
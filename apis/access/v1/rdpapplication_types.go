@@ -0,0 +1,68 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"bitbucket.org/accezz-io/sac-operator/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RdpApplicationSpec defines the desired state of RdpApplication
+type RdpApplicationSpec struct {
+	CommonApplicationParams `json:",inline"`
+
+	// SubType of the application. Valid values are: SINGLE_MACHINE, MULTIPLE_MACHINES
+	// (default is SINGLE_MACHINE)
+	// +kubebuilder:validation:Enum=SINGLE_MACHINE;MULTIPLE_MACHINES
+	// +kubebuilder:default=SINGLE_MACHINE
+	SubType model.ApplicationSubType `json:"sub_type,omitempty"`
+
+	// The machine exposed by a SINGLE_MACHINE application.
+	// +optional
+	Service *Service `json:"service,omitempty"`
+
+	// The machines exposed by a MULTIPLE_MACHINES application.
+	// +optional
+	Services []Service `json:"services,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// RdpApplication is the Schema for the rdpapplications API
+type RdpApplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RdpApplicationSpec      `json:"spec,omitempty"`
+	Status CommonApplicationStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// RdpApplicationList contains a list of RdpApplication
+type RdpApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RdpApplication `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RdpApplication{}, &RdpApplicationList{})
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SecureAccessCloudTenantSpec defines the desired state of SecureAccessCloudTenant
+type SecureAccessCloudTenantSpec struct {
+
+	// The secret holding the tenant API client credentials under the tenantDomain, clientId and clientSecret keys.
+	// +kubebuilder:validation:Required
+	CredentialsSecretRef SecretReference `json:"credentials_secret_ref"`
+}
+
+type SecretReference struct {
+
+	// The secret name
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// The secret namespace
+	// +kubebuilder:validation:Required
+	Namespace string `json:"namespace"`
+}
+
+// SecureAccessCloudTenantStatus defines the observed state of SecureAccessCloudTenant
+type SecureAccessCloudTenantStatus struct {
+
+	// The tenant domain read from the credentials secret.
+	// +optional
+	TenantDomain string `json:"tenant_domain,omitempty"`
+
+	// Whether an access token was successfully obtained with the tenant credentials.
+	CredentialsValid bool `json:"credentials_valid"`
+
+	// The reason the credentials are not valid.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Information when was the last time the credentials were validated by the operator.
+	// +optional
+	LastValidated metav1.Time `json:"last_validated,omitempty"`
+
+	// The generation of the credentials in use by the tenant client, incremented every time the credentials change.
+	// +optional
+	CredentialsGeneration int64 `json:"credentials_generation,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope=Cluster
+//+kubebuilder:printcolumn:name="Tenant Domain",type=string,JSONPath=`.status.tenant_domain`
+//+kubebuilder:printcolumn:name="Valid",type=boolean,JSONPath=`.status.credentials_valid`
+//+kubebuilder:printcolumn:name="Credentials Generation",type=integer,JSONPath=`.status.credentials_generation`
+
+// SecureAccessCloudTenant is the Schema for the secureaccesscloudtenants API
+type SecureAccessCloudTenant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecureAccessCloudTenantSpec   `json:"spec,omitempty"`
+	Status SecureAccessCloudTenantStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// SecureAccessCloudTenantList contains a list of SecureAccessCloudTenant
+type SecureAccessCloudTenantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecureAccessCloudTenant `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SecureAccessCloudTenant{}, &SecureAccessCloudTenantList{})
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package v1
 
 import (
+	"bitbucket.org/accezz-io/sac-operator/model"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -28,11 +29,21 @@ type SiteSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
-	// number_of_connectors to create for this site
+	// number_of_connectors to create for this site (default is the minimum number of connectors of the namespace)
+	// +kubebuilder:validation:Minimum=0
 	NumberOfConnectors int `json:"number_of_connectors"`
 	// dockerhub image pull secret default is none
 	// +optional
 	ImagePullSecret string `json:"image_pull_secret"`
+	// The SecureAccessCloudTenant this site is created in (default is the operator default tenant)
+	// +optional
+	TenantRef string `json:"tenant_ref,omitempty"`
+	// What to do when the site was deleted in Secure-Access-Cloud outside the cluster. Valid values are: Correct,
+	// Report (default is Correct)
+	// +kubebuilder:validation:Enum=Correct;Report
+	// +kubebuilder:default=Correct
+	// +optional
+	DriftPolicy model.DriftPolicy `json:"drift_policy,omitempty"`
 }
 
 // SiteStatus defines the observed state of Site
@@ -44,11 +55,28 @@ type SiteStatus struct {
 	UnHealthyConnectors       map[string]string `json:"un_healthy_connectors"`
 	NumberOfHealthyConnectors int               `json:"number_of_healthy_connectors"`
 	Selector                  string            `json:"selector"` // this must be the string form of the selector
+
+	// The generation of the site spec the status was computed from.
+	// +optional
+	ObservedGeneration int64 `json:"observed_generation,omitempty"`
+
+	// The fields of the site which drifted in Secure-Access-Cloud and were not corrected, according to the drift
+	// policy.
+	// +optional
+	Drift []string `json:"drift,omitempty"`
+
+	// The latest observations of the site state: Ready, Synced, ConnectorsAvailable and Degraded.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:subresource:scale:specpath=.spec.number_of_connectors,statuspath=.status.NumberOfHealthyConnectors,selectorpath=.status.selector
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Site is the Schema for the sites API
 type Site struct {
@@ -0,0 +1,76 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SshApplicationSpec defines the desired state of SshApplication
+type SshApplicationSpec struct {
+	CommonApplicationParams `json:",inline"`
+
+	Service `json:"service"`
+
+	// +optional
+	*SshSettings `json:"ssh_settings,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// SshApplication is the Schema for the sshapplications API
+type SshApplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SshApplicationSpec      `json:"spec,omitempty"`
+	Status CommonApplicationStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// SshApplicationList contains a list of SshApplication
+type SshApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SshApplication `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SshApplication{}, &SshApplicationList{})
+}
+
+type SshSettings struct {
+	// The user accounts (usernames) that users can log in with on the target machine.
+	// +kubebuilder:validation:Optional
+	UserAccounts []string `json:"user_accounts,omitempty"`
+	// Allow users to log in with their full UPN (e.g. john@example.com) as the username.
+	// +kubebuilder:default=false
+	// +kubebuilder:validation:Optional
+	FullUPNAutoGenerate *bool `json:"full_upn_auto_generate,omitempty"`
+	// Allow users to log in with the prefix of their UPN (e.g. john) as the username.
+	// +kubebuilder:default=false
+	// +kubebuilder:validation:Optional
+	UPNAutoGenerate *bool `json:"upn_auto_generate,omitempty"`
+	// Allow users to log in with their email address as the username.
+	// +kubebuilder:default=false
+	// +kubebuilder:validation:Optional
+	EmailAutoGenerate *bool `json:"email_auto_generate,omitempty"`
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TcpApplicationSpec defines the desired state of TcpApplication
+type TcpApplicationSpec struct {
+	CommonApplicationParams `json:",inline"`
+
+	// The targets exposed by this application.
+	// +kubebuilder:validation:MinItems=1
+	Targets []TcpTarget `json:"targets"`
+}
+
+type TcpTarget struct {
+
+	// The service exposed by this target. Exactly one of service or host should be set.
+	// +optional
+	Service *ServiceReference `json:"service,omitempty"`
+
+	// A host name or IP address, reachable from the site connectors, exposed by this target.
+	// Exactly one of service or host should be set.
+	// +optional
+	Host string `json:"host,omitempty"`
+
+	// The ports or port ranges exposed on this target (e.g. "5432" or "9092-9094").
+	// +kubebuilder:validation:MinItems=1
+	Ports []string `json:"ports"`
+}
+
+type ServiceReference struct {
+
+	// The service name
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// The service namespace (default is the application's namespace)
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// TcpApplication is the Schema for the tcpapplications API
+type TcpApplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TcpApplicationSpec      `json:"spec,omitempty"`
+	Status CommonApplicationStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TcpApplicationList contains a list of TcpApplication
+type TcpApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TcpApplication `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TcpApplication{}, &TcpApplicationList{})
+}
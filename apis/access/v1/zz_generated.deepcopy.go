@@ -22,67 +22,103 @@ limitations under the License.
 package v1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CommonApplicationParams) DeepCopyInto(out *CommonApplicationParams) {
+func (in *AccessPolicy) DeepCopyInto(out *AccessPolicy) {
 	*out = *in
-	if in.AccessPoliciesNames != nil {
-		in, out := &in.AccessPoliciesNames, &out.AccessPoliciesNames
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ActivityPoliciesNames != nil {
-		in, out := &in.ActivityPoliciesNames, &out.ActivityPoliciesNames
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.IsVisible != nil {
-		in, out := &in.IsVisible, &out.IsVisible
-		*out = new(bool)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPolicy.
+func (in *AccessPolicy) DeepCopy() *AccessPolicy {
+	if in == nil {
+		return nil
 	}
-	if in.IsNotificationEnabled != nil {
-		in, out := &in.IsNotificationEnabled, &out.IsNotificationEnabled
-		*out = new(bool)
-		**out = **in
+	out := new(AccessPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPolicyList) DeepCopyInto(out *AccessPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonApplicationParams.
-func (in *CommonApplicationParams) DeepCopy() *CommonApplicationParams {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPolicyList.
+func (in *AccessPolicyList) DeepCopy() *AccessPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(CommonApplicationParams)
+	out := new(AccessPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CommonApplicationStatus) DeepCopyInto(out *CommonApplicationStatus) {
+func (in *AccessPolicySpec) DeepCopyInto(out *AccessPolicySpec) {
 	*out = *in
-	in.ModifiedOn.DeepCopyInto(&out.ModifiedOn)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DirectoryEntities != nil {
+		in, out := &in.DirectoryEntities, &out.DirectoryEntities
+		*out = make([]DirectoryEntity, len(*in))
+		copy(*out, *in)
+	}
+	if in.FilterConditions != nil {
+		in, out := &in.FilterConditions, &out.FilterConditions
+		*out = make([]FilterCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonApplicationStatus.
-func (in *CommonApplicationStatus) DeepCopy() *CommonApplicationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPolicySpec.
+func (in *AccessPolicySpec) DeepCopy() *AccessPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CommonApplicationStatus)
+	out := new(AccessPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HttpApplication) DeepCopyInto(out *HttpApplication) {
+func (in *ActivityPolicy) DeepCopyInto(out *ActivityPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -90,18 +126,18 @@ func (in *HttpApplication) DeepCopyInto(out *HttpApplication) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpApplication.
-func (in *HttpApplication) DeepCopy() *HttpApplication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivityPolicy.
+func (in *ActivityPolicy) DeepCopy() *ActivityPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(HttpApplication)
+	out := new(ActivityPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *HttpApplication) DeepCopyObject() runtime.Object {
+func (in *ActivityPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -109,31 +145,31 @@ func (in *HttpApplication) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HttpApplicationList) DeepCopyInto(out *HttpApplicationList) {
+func (in *ActivityPolicyList) DeepCopyInto(out *ActivityPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]HttpApplication, len(*in))
+		*out = make([]ActivityPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpApplicationList.
-func (in *HttpApplicationList) DeepCopy() *HttpApplicationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivityPolicyList.
+func (in *ActivityPolicyList) DeepCopy() *ActivityPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(HttpApplicationList)
+	out := new(ActivityPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *HttpApplicationList) DeepCopyObject() runtime.Object {
+func (in *ActivityPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -141,145 +177,253 @@ func (in *HttpApplicationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HttpApplicationSpec) DeepCopyInto(out *HttpApplicationSpec) {
+func (in *ActivityPolicySpec) DeepCopyInto(out *ActivityPolicySpec) {
 	*out = *in
-	in.CommonApplicationParams.DeepCopyInto(&out.CommonApplicationParams)
-	out.Service = in.Service
-	if in.HttpConnectionSettings != nil {
-		in, out := &in.HttpConnectionSettings, &out.HttpConnectionSettings
-		*out = new(HttpConnectionSettings)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
 		**out = **in
 	}
-	if in.HttpLinkTranslationSettings != nil {
-		in, out := &in.HttpLinkTranslationSettings, &out.HttpLinkTranslationSettings
-		*out = new(HttpLinkTranslationSettings)
-		(*in).DeepCopyInto(*out)
+	if in.DirectoryEntities != nil {
+		in, out := &in.DirectoryEntities, &out.DirectoryEntities
+		*out = make([]DirectoryEntity, len(*in))
+		copy(*out, *in)
 	}
-	if in.HttpRequestCustomizationSettings != nil {
-		in, out := &in.HttpRequestCustomizationSettings, &out.HttpRequestCustomizationSettings
-		*out = new(HttpRequestCustomizationSettings)
-		(*in).DeepCopyInto(*out)
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ActivityRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpApplicationSpec.
-func (in *HttpApplicationSpec) DeepCopy() *HttpApplicationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivityPolicySpec.
+func (in *ActivityPolicySpec) DeepCopy() *ActivityPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HttpApplicationSpec)
+	out := new(ActivityPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HttpConnectionSettings) DeepCopyInto(out *HttpConnectionSettings) {
+func (in *ActivityRule) DeepCopyInto(out *ActivityRule) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ActivityRuleCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]ActivityRuleTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpConnectionSettings.
-func (in *HttpConnectionSettings) DeepCopy() *HttpConnectionSettings {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivityRule.
+func (in *ActivityRule) DeepCopy() *ActivityRule {
 	if in == nil {
 		return nil
 	}
-	out := new(HttpConnectionSettings)
+	out := new(ActivityRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HttpLinkTranslationSettings) DeepCopyInto(out *HttpLinkTranslationSettings) {
+func (in *ActivityRuleCondition) DeepCopyInto(out *ActivityRuleCondition) {
 	*out = *in
-	if in.IsDefaultContentRewriteRulesEnabled != nil {
-		in, out := &in.IsDefaultContentRewriteRulesEnabled, &out.IsDefaultContentRewriteRulesEnabled
+	if in.Arguments != nil {
+		in, out := &in.Arguments, &out.Arguments
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivityRuleCondition.
+func (in *ActivityRuleCondition) DeepCopy() *ActivityRuleCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivityRuleCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActivityRuleTarget) DeepCopyInto(out *ActivityRuleTarget) {
+	*out = *in
+	if in.Arguments != nil {
+		in, out := &in.Arguments, &out.Arguments
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivityRuleTarget.
+func (in *ActivityRuleTarget) DeepCopy() *ActivityRuleTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivityRuleTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommonApplicationParams) DeepCopyInto(out *CommonApplicationParams) {
+	*out = *in
+	if in.AccessPoliciesNames != nil {
+		in, out := &in.AccessPoliciesNames, &out.AccessPoliciesNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ActivityPoliciesNames != nil {
+		in, out := &in.ActivityPoliciesNames, &out.ActivityPoliciesNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IsVisible != nil {
+		in, out := &in.IsVisible, &out.IsVisible
 		*out = new(bool)
 		**out = **in
 	}
-	if in.IsDefaultHeaderRewriteRulesEnabled != nil {
-		in, out := &in.IsDefaultHeaderRewriteRulesEnabled, &out.IsDefaultHeaderRewriteRulesEnabled
+	if in.IsNotificationEnabled != nil {
+		in, out := &in.IsNotificationEnabled, &out.IsNotificationEnabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.UseExternalAddressForHostAndSni != nil {
-		in, out := &in.UseExternalAddressForHostAndSni, &out.UseExternalAddressForHostAndSni
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.LinkedApplications != nil {
-		in, out := &in.LinkedApplications, &out.LinkedApplications
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonApplicationParams.
+func (in *CommonApplicationParams) DeepCopy() *CommonApplicationParams {
+	if in == nil {
+		return nil
+	}
+	out := new(CommonApplicationParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommonApplicationStatus) DeepCopyInto(out *CommonApplicationStatus) {
+	*out = *in
+	in.ModifiedOn.DeepCopyInto(&out.ModifiedOn)
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpLinkTranslationSettings.
-func (in *HttpLinkTranslationSettings) DeepCopy() *HttpLinkTranslationSettings {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonApplicationStatus.
+func (in *CommonApplicationStatus) DeepCopy() *CommonApplicationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(HttpLinkTranslationSettings)
+	out := new(CommonApplicationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HttpRequestCustomizationSettings) DeepCopyInto(out *HttpRequestCustomizationSettings) {
+func (in *CommonPolicyStatus) DeepCopyInto(out *CommonPolicyStatus) {
 	*out = *in
-	if in.HeaderCustomization != nil {
-		in, out := &in.HeaderCustomization, &out.HeaderCustomization
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	in.ModifiedOn.DeepCopyInto(&out.ModifiedOn)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpRequestCustomizationSettings.
-func (in *HttpRequestCustomizationSettings) DeepCopy() *HttpRequestCustomizationSettings {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonPolicyStatus.
+func (in *CommonPolicyStatus) DeepCopy() *CommonPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(HttpRequestCustomizationSettings)
+	out := new(CommonPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Service) DeepCopyInto(out *Service) {
+func (in *DirectoryEntity) DeepCopyInto(out *DirectoryEntity) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Service.
-func (in *Service) DeepCopy() *Service {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectoryEntity.
+func (in *DirectoryEntity) DeepCopy() *DirectoryEntity {
 	if in == nil {
 		return nil
 	}
-	out := new(Service)
+	out := new(DirectoryEntity)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Site) DeepCopyInto(out *Site) {
+func (in *DynamicSshApplication) DeepCopyInto(out *DynamicSshApplication) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Site.
-func (in *Site) DeepCopy() *Site {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicSshApplication.
+func (in *DynamicSshApplication) DeepCopy() *DynamicSshApplication {
 	if in == nil {
 		return nil
 	}
-	out := new(Site)
+	out := new(DynamicSshApplication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Site) DeepCopyObject() runtime.Object {
+func (in *DynamicSshApplication) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -287,31 +431,31 @@ func (in *Site) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SiteList) DeepCopyInto(out *SiteList) {
+func (in *DynamicSshApplicationList) DeepCopyInto(out *DynamicSshApplicationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Site, len(*in))
+		*out = make([]DynamicSshApplication, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SiteList.
-func (in *SiteList) DeepCopy() *SiteList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicSshApplicationList.
+func (in *DynamicSshApplicationList) DeepCopy() *DynamicSshApplicationList {
 	if in == nil {
 		return nil
 	}
-	out := new(SiteList)
+	out := new(DynamicSshApplicationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SiteList) DeepCopyObject() runtime.Object {
+func (in *DynamicSshApplicationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -319,40 +463,573 @@ func (in *SiteList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SiteSpec) DeepCopyInto(out *SiteSpec) {
+func (in *DynamicSshApplicationSpec) DeepCopyInto(out *DynamicSshApplicationSpec) {
 	*out = *in
+	in.CommonApplicationParams.DeepCopyInto(&out.CommonApplicationParams)
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.SshSettings != nil {
+		in, out := &in.SshSettings, &out.SshSettings
+		*out = new(SshSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SiteSpec.
-func (in *SiteSpec) DeepCopy() *SiteSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicSshApplicationSpec.
+func (in *DynamicSshApplicationSpec) DeepCopy() *DynamicSshApplicationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SiteSpec)
+	out := new(DynamicSshApplicationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SiteStatus) DeepCopyInto(out *SiteStatus) {
+func (in *DynamicSshApplicationStatus) DeepCopyInto(out *DynamicSshApplicationStatus) {
 	*out = *in
-	if in.HealthyConnectors != nil {
-		in, out := &in.HealthyConnectors, &out.HealthyConnectors
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.UnHealthyConnectors != nil {
-		in, out := &in.UnHealthyConnectors, &out.UnHealthyConnectors
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	in.CommonApplicationStatus.DeepCopyInto(&out.CommonApplicationStatus)
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SiteStatus.
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicSshApplicationStatus.
+func (in *DynamicSshApplicationStatus) DeepCopy() *DynamicSshApplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicSshApplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterCondition) DeepCopyInto(out *FilterCondition) {
+	*out = *in
+	if in.Positive != nil {
+		in, out := &in.Positive, &out.Positive
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Arguments != nil {
+		in, out := &in.Arguments, &out.Arguments
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterCondition.
+func (in *FilterCondition) DeepCopy() *FilterCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpApplication) DeepCopyInto(out *HttpApplication) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpApplication.
+func (in *HttpApplication) DeepCopy() *HttpApplication {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpApplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HttpApplication) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpApplicationList) DeepCopyInto(out *HttpApplicationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HttpApplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpApplicationList.
+func (in *HttpApplicationList) DeepCopy() *HttpApplicationList {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpApplicationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HttpApplicationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpApplicationSpec) DeepCopyInto(out *HttpApplicationSpec) {
+	*out = *in
+	in.CommonApplicationParams.DeepCopyInto(&out.CommonApplicationParams)
+	out.Service = in.Service
+	if in.HttpConnectionSettings != nil {
+		in, out := &in.HttpConnectionSettings, &out.HttpConnectionSettings
+		*out = new(HttpConnectionSettings)
+		**out = **in
+	}
+	if in.HttpLinkTranslationSettings != nil {
+		in, out := &in.HttpLinkTranslationSettings, &out.HttpLinkTranslationSettings
+		*out = new(HttpLinkTranslationSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HttpRequestCustomizationSettings != nil {
+		in, out := &in.HttpRequestCustomizationSettings, &out.HttpRequestCustomizationSettings
+		*out = new(HttpRequestCustomizationSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpApplicationSpec.
+func (in *HttpApplicationSpec) DeepCopy() *HttpApplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpApplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpConnectionSettings) DeepCopyInto(out *HttpConnectionSettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpConnectionSettings.
+func (in *HttpConnectionSettings) DeepCopy() *HttpConnectionSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpConnectionSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpLinkTranslationSettings) DeepCopyInto(out *HttpLinkTranslationSettings) {
+	*out = *in
+	if in.IsDefaultContentRewriteRulesEnabled != nil {
+		in, out := &in.IsDefaultContentRewriteRulesEnabled, &out.IsDefaultContentRewriteRulesEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IsDefaultHeaderRewriteRulesEnabled != nil {
+		in, out := &in.IsDefaultHeaderRewriteRulesEnabled, &out.IsDefaultHeaderRewriteRulesEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UseExternalAddressForHostAndSni != nil {
+		in, out := &in.UseExternalAddressForHostAndSni, &out.UseExternalAddressForHostAndSni
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LinkedApplications != nil {
+		in, out := &in.LinkedApplications, &out.LinkedApplications
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpLinkTranslationSettings.
+func (in *HttpLinkTranslationSettings) DeepCopy() *HttpLinkTranslationSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpLinkTranslationSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpRequestCustomizationSettings) DeepCopyInto(out *HttpRequestCustomizationSettings) {
+	*out = *in
+	if in.HeaderCustomization != nil {
+		in, out := &in.HeaderCustomization, &out.HeaderCustomization
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpRequestCustomizationSettings.
+func (in *HttpRequestCustomizationSettings) DeepCopy() *HttpRequestCustomizationSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpRequestCustomizationSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RdpApplication) DeepCopyInto(out *RdpApplication) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RdpApplication.
+func (in *RdpApplication) DeepCopy() *RdpApplication {
+	if in == nil {
+		return nil
+	}
+	out := new(RdpApplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RdpApplication) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RdpApplicationList) DeepCopyInto(out *RdpApplicationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RdpApplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RdpApplicationList.
+func (in *RdpApplicationList) DeepCopy() *RdpApplicationList {
+	if in == nil {
+		return nil
+	}
+	out := new(RdpApplicationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RdpApplicationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RdpApplicationSpec) DeepCopyInto(out *RdpApplicationSpec) {
+	*out = *in
+	in.CommonApplicationParams.DeepCopyInto(&out.CommonApplicationParams)
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(Service)
+		**out = **in
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]Service, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RdpApplicationSpec.
+func (in *RdpApplicationSpec) DeepCopy() *RdpApplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RdpApplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretReference) DeepCopyInto(out *SecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReference.
+func (in *SecretReference) DeepCopy() *SecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecureAccessCloudTenant) DeepCopyInto(out *SecureAccessCloudTenant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecureAccessCloudTenant.
+func (in *SecureAccessCloudTenant) DeepCopy() *SecureAccessCloudTenant {
+	if in == nil {
+		return nil
+	}
+	out := new(SecureAccessCloudTenant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecureAccessCloudTenant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecureAccessCloudTenantList) DeepCopyInto(out *SecureAccessCloudTenantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecureAccessCloudTenant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecureAccessCloudTenantList.
+func (in *SecureAccessCloudTenantList) DeepCopy() *SecureAccessCloudTenantList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecureAccessCloudTenantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecureAccessCloudTenantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecureAccessCloudTenantSpec) DeepCopyInto(out *SecureAccessCloudTenantSpec) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecureAccessCloudTenantSpec.
+func (in *SecureAccessCloudTenantSpec) DeepCopy() *SecureAccessCloudTenantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecureAccessCloudTenantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecureAccessCloudTenantStatus) DeepCopyInto(out *SecureAccessCloudTenantStatus) {
+	*out = *in
+	in.LastValidated.DeepCopyInto(&out.LastValidated)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecureAccessCloudTenantStatus.
+func (in *SecureAccessCloudTenantStatus) DeepCopy() *SecureAccessCloudTenantStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecureAccessCloudTenantStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Service) DeepCopyInto(out *Service) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Service.
+func (in *Service) DeepCopy() *Service {
+	if in == nil {
+		return nil
+	}
+	out := new(Service)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceReference) DeepCopyInto(out *ServiceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceReference.
+func (in *ServiceReference) DeepCopy() *ServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Site) DeepCopyInto(out *Site) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Site.
+func (in *Site) DeepCopy() *Site {
+	if in == nil {
+		return nil
+	}
+	out := new(Site)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Site) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SiteList) DeepCopyInto(out *SiteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Site, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SiteList.
+func (in *SiteList) DeepCopy() *SiteList {
+	if in == nil {
+		return nil
+	}
+	out := new(SiteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SiteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SiteSpec) DeepCopyInto(out *SiteSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SiteSpec.
+func (in *SiteSpec) DeepCopy() *SiteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SiteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SiteStatus) DeepCopyInto(out *SiteStatus) {
+	*out = *in
+	if in.HealthyConnectors != nil {
+		in, out := &in.HealthyConnectors, &out.HealthyConnectors
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.UnHealthyConnectors != nil {
+		in, out := &in.UnHealthyConnectors, &out.UnHealthyConnectors
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SiteStatus.
 func (in *SiteStatus) DeepCopy() *SiteStatus {
 	if in == nil {
 		return nil
@@ -361,3 +1038,226 @@ func (in *SiteStatus) DeepCopy() *SiteStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SshApplication) DeepCopyInto(out *SshApplication) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SshApplication.
+func (in *SshApplication) DeepCopy() *SshApplication {
+	if in == nil {
+		return nil
+	}
+	out := new(SshApplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SshApplication) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SshApplicationList) DeepCopyInto(out *SshApplicationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SshApplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SshApplicationList.
+func (in *SshApplicationList) DeepCopy() *SshApplicationList {
+	if in == nil {
+		return nil
+	}
+	out := new(SshApplicationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SshApplicationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SshApplicationSpec) DeepCopyInto(out *SshApplicationSpec) {
+	*out = *in
+	in.CommonApplicationParams.DeepCopyInto(&out.CommonApplicationParams)
+	out.Service = in.Service
+	if in.SshSettings != nil {
+		in, out := &in.SshSettings, &out.SshSettings
+		*out = new(SshSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SshApplicationSpec.
+func (in *SshApplicationSpec) DeepCopy() *SshApplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SshApplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SshSettings) DeepCopyInto(out *SshSettings) {
+	*out = *in
+	if in.UserAccounts != nil {
+		in, out := &in.UserAccounts, &out.UserAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FullUPNAutoGenerate != nil {
+		in, out := &in.FullUPNAutoGenerate, &out.FullUPNAutoGenerate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UPNAutoGenerate != nil {
+		in, out := &in.UPNAutoGenerate, &out.UPNAutoGenerate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EmailAutoGenerate != nil {
+		in, out := &in.EmailAutoGenerate, &out.EmailAutoGenerate
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SshSettings.
+func (in *SshSettings) DeepCopy() *SshSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(SshSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TcpApplication) DeepCopyInto(out *TcpApplication) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TcpApplication.
+func (in *TcpApplication) DeepCopy() *TcpApplication {
+	if in == nil {
+		return nil
+	}
+	out := new(TcpApplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TcpApplication) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TcpApplicationList) DeepCopyInto(out *TcpApplicationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TcpApplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TcpApplicationList.
+func (in *TcpApplicationList) DeepCopy() *TcpApplicationList {
+	if in == nil {
+		return nil
+	}
+	out := new(TcpApplicationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TcpApplicationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TcpApplicationSpec) DeepCopyInto(out *TcpApplicationSpec) {
+	*out = *in
+	in.CommonApplicationParams.DeepCopyInto(&out.CommonApplicationParams)
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]TcpTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TcpApplicationSpec.
+func (in *TcpApplicationSpec) DeepCopy() *TcpApplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TcpApplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TcpTarget) DeepCopyInto(out *TcpTarget) {
+	*out = *in
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(ServiceReference)
+		**out = **in
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TcpTarget.
+func (in *TcpTarget) DeepCopy() *TcpTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(TcpTarget)
+	in.DeepCopyInto(out)
+	return out
+}
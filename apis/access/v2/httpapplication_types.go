@@ -0,0 +1,226 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"bitbucket.org/accezz-io/sac-operator/model"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HttpApplicationSpec defines the desired state of HttpApplication
+type HttpApplicationSpec struct {
+	// The site to bind this application. The site should be an existing Site in your Secure Access Cloud tenant
+	Site string `json:"site"`
+
+	// The SecureAccessCloudTenant this application is created in (default is the operator default tenant)
+	// +optional
+	TenantRef string `json:"tenantRef,omitempty"`
+
+	// The exposed Service and how it is reached by the connectors
+	Target HttpTarget `json:"target"`
+
+	// How the application is exposed to the users
+	// +optional
+	Exposure HttpExposure `json:"exposure,omitempty"`
+
+	// The policies enforced on this application
+	// +optional
+	Policies Policies `json:"policies,omitempty"`
+
+	// +optional
+	LinkTranslation *HttpLinkTranslation `json:"linkTranslation,omitempty"`
+
+	// +optional
+	RequestCustomization *HttpRequestCustomization `json:"requestCustomization,omitempty"`
+
+	// +kubebuilder:default=true
+	// +optional
+	Visible *bool `json:"visible,omitempty"`
+
+	// +kubebuilder:default=false
+	// +optional
+	NotificationEnabled *bool `json:"notificationEnabled,omitempty"`
+
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// What to do when the application was modified or deleted in Secure-Access-Cloud outside the cluster. Valid values
+	// are: Correct, Report (default is Correct)
+	// +kubebuilder:validation:Enum=Correct;Report
+	// +kubebuilder:default=Correct
+	// +optional
+	DriftPolicy model.DriftPolicy `json:"driftPolicy,omitempty"`
+}
+
+type HttpTarget struct {
+	// The exposed Service
+	Service ServiceReference `json:"service"`
+
+	// The root path of the application in the Service
+	// +optional
+	RootPath string `json:"rootPath,omitempty"`
+
+	// The path checked by the connectors to report the application health
+	// +optional
+	HealthURL string `json:"healthUrl,omitempty"`
+
+	// The http method of the health check
+	// +optional
+	HealthMethod string `json:"healthMethod,omitempty"`
+}
+
+type ServiceReference struct {
+	// The service name
+	Name string `json:"name"`
+
+	// The service namespace (default is the application's namespace)
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// The port that will be exposed by this application: the name or the number of a port of the service, or of its
+	// target port. The port is resolved to the number of the matching service port.
+	Port string `json:"port"`
+
+	// Protocol Schema (default is based on the appProtocol or the name of the service port, then on the port number)
+	// +optional
+	Schema string `json:"schema,omitempty"`
+}
+
+// HttpExposureType defines the domain an application is exposed on.
+// +kubebuilder:validation:Enum=TenantDomain;CustomDomain;WildcardDomain
+type HttpExposureType string
+
+const (
+	// TenantDomainExposure exposes the application on a subdomain of the tenant domain.
+	TenantDomainExposure HttpExposureType = "TenantDomain"
+	// CustomDomainExposure exposes the application on a custom external address.
+	CustomDomainExposure HttpExposureType = "CustomDomain"
+	// WildcardDomainExposure exposes the application on a wildcard external address.
+	WildcardDomainExposure HttpExposureType = "WildcardDomain"
+)
+
+type HttpExposure struct {
+	// The domain the application is exposed on. Valid values are: TenantDomain, CustomDomain, WildcardDomain
+	// (default is TenantDomain)
+	// +kubebuilder:default=TenantDomain
+	// +optional
+	Type HttpExposureType `json:"type,omitempty"`
+
+	// The subdomain of the tenant domain
+	// +optional
+	SubDomain string `json:"subdomain,omitempty"`
+
+	// The external address of a custom or wildcard domain
+	// +optional
+	ExternalAddress string `json:"externalAddress,omitempty"`
+
+	// A kubernetes.io/tls Secret in the application namespace holding the certificate and the private key of the
+	// custom or wildcard domain
+	// +optional
+	CertificateSecretRef *corev1.LocalObjectReference `json:"certificateSecretRef,omitempty"`
+}
+
+type Policies struct {
+	// The names of the access policies enforced on this application
+	// +optional
+	Access []string `json:"access,omitempty"`
+
+	// The names of the activity policies enforced on this application
+	// +optional
+	Activity []string `json:"activity,omitempty"`
+}
+
+type HttpLinkTranslation struct {
+	// +kubebuilder:default=true
+	// +optional
+	DefaultContentRewriteRulesEnabled *bool `json:"defaultContentRewriteRulesEnabled,omitempty"`
+	// +kubebuilder:default=true
+	// +optional
+	DefaultHeaderRewriteRulesEnabled *bool `json:"defaultHeaderRewriteRulesEnabled,omitempty"`
+	// +kubebuilder:default=false
+	// +optional
+	UseExternalAddressForHostAndSni *bool `json:"useExternalAddressForHostAndSni,omitempty"`
+	// +optional
+	LinkedApplications []string `json:"linkedApplications,omitempty"`
+}
+
+type HttpRequestCustomization struct {
+	// The headers added to the requests sent to the application
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// HttpApplicationStatus defines the observed state of HttpApplication
+type HttpApplicationStatus struct {
+	// The application-id in Secure-Access-Cloud
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Information when was the last time the application was successfully modified by the operator.
+	// +optional
+	ModifiedOn metav1.Time `json:"modifiedOn,omitempty"`
+
+	// The generation of the application spec the status was computed from.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// The internal address of the application, with the named port and the schema resolved from the exposed Service.
+	// +optional
+	InternalAddress string `json:"internalAddress,omitempty"`
+
+	// The fields of the application which drifted in Secure-Access-Cloud and were not corrected, according to the
+	// drift policy.
+	// +optional
+	Drift []string `json:"drift,omitempty"`
+
+	// The latest observations of the application state: Ready, Synced, SiteBound, PoliciesBound, ServiceAvailable and
+	// Degraded.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:storageversion
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// HttpApplication is the Schema for the httpapplications API
+type HttpApplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HttpApplicationSpec   `json:"spec,omitempty"`
+	Status HttpApplicationStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// HttpApplicationList contains a list of HttpApplication
+type HttpApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HttpApplication `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HttpApplication{}, &HttpApplicationList{})
+}
@@ -0,0 +1,291 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpApplication) DeepCopyInto(out *HttpApplication) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpApplication.
+func (in *HttpApplication) DeepCopy() *HttpApplication {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpApplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HttpApplication) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpApplicationList) DeepCopyInto(out *HttpApplicationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HttpApplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpApplicationList.
+func (in *HttpApplicationList) DeepCopy() *HttpApplicationList {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpApplicationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HttpApplicationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpApplicationSpec) DeepCopyInto(out *HttpApplicationSpec) {
+	*out = *in
+	out.Target = in.Target
+	in.Exposure.DeepCopyInto(&out.Exposure)
+	in.Policies.DeepCopyInto(&out.Policies)
+	if in.LinkTranslation != nil {
+		in, out := &in.LinkTranslation, &out.LinkTranslation
+		*out = new(HttpLinkTranslation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequestCustomization != nil {
+		in, out := &in.RequestCustomization, &out.RequestCustomization
+		*out = new(HttpRequestCustomization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Visible != nil {
+		in, out := &in.Visible, &out.Visible
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NotificationEnabled != nil {
+		in, out := &in.NotificationEnabled, &out.NotificationEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpApplicationSpec.
+func (in *HttpApplicationSpec) DeepCopy() *HttpApplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpApplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpApplicationStatus) DeepCopyInto(out *HttpApplicationStatus) {
+	*out = *in
+	in.ModifiedOn.DeepCopyInto(&out.ModifiedOn)
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpApplicationStatus.
+func (in *HttpApplicationStatus) DeepCopy() *HttpApplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpApplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpExposure) DeepCopyInto(out *HttpExposure) {
+	*out = *in
+	if in.CertificateSecretRef != nil {
+		in, out := &in.CertificateSecretRef, &out.CertificateSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpExposure.
+func (in *HttpExposure) DeepCopy() *HttpExposure {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpExposure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpLinkTranslation) DeepCopyInto(out *HttpLinkTranslation) {
+	*out = *in
+	if in.DefaultContentRewriteRulesEnabled != nil {
+		in, out := &in.DefaultContentRewriteRulesEnabled, &out.DefaultContentRewriteRulesEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefaultHeaderRewriteRulesEnabled != nil {
+		in, out := &in.DefaultHeaderRewriteRulesEnabled, &out.DefaultHeaderRewriteRulesEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UseExternalAddressForHostAndSni != nil {
+		in, out := &in.UseExternalAddressForHostAndSni, &out.UseExternalAddressForHostAndSni
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LinkedApplications != nil {
+		in, out := &in.LinkedApplications, &out.LinkedApplications
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpLinkTranslation.
+func (in *HttpLinkTranslation) DeepCopy() *HttpLinkTranslation {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpLinkTranslation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpRequestCustomization) DeepCopyInto(out *HttpRequestCustomization) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpRequestCustomization.
+func (in *HttpRequestCustomization) DeepCopy() *HttpRequestCustomization {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpRequestCustomization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HttpTarget) DeepCopyInto(out *HttpTarget) {
+	*out = *in
+	out.Service = in.Service
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpTarget.
+func (in *HttpTarget) DeepCopy() *HttpTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(HttpTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Policies) DeepCopyInto(out *Policies) {
+	*out = *in
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Activity != nil {
+		in, out := &in.Activity, &out.Activity
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policies.
+func (in *Policies) DeepCopy() *Policies {
+	if in == nil {
+		return nil
+	}
+	out := new(Policies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceReference) DeepCopyInto(out *ServiceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceReference.
+func (in *ServiceReference) DeepCopy() *ServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package access
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"bitbucket.org/accezz-io/sac-operator/service"
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
+
+	"bitbucket.org/accezz-io/sac-operator/controllers/access/converter"
+
+	"github.com/go-logr/logr"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AccessPolicyReconciler reconciles a AccessPolicy object
+type AccessPolicyReconciler struct {
+	client.Client
+	Scheme                   *runtime.Scheme
+	SecureAccessCloudClients *sac.SecureAccessCloudClientRegistry
+	Recorder                 record.EventRecorder
+	ConverterToModel         *converter.AccessPolicyConverter
+	Log                      logr.Logger
+}
+
+//+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=accesspolicies,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=accesspolicies/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=accesspolicies/finalizers,verbs=update
+
+// Reconcile converts the AccessPolicy into an access policy model and reconciles it in Secure-Access-Cloud using the
+// PolicyService.
+func (r *AccessPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	policy := &accessv1.AccessPolicy{}
+
+	if err := r.Get(ctx, req.NamespacedName, policy); err != nil {
+		r.Log.Error(err, "unable to fetch policy")
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	model := r.ConverterToModel.ConvertToModel(policy)
+
+	handler := newPolicyReconcileHandler(r.Client, r.SecureAccessCloudClients, r.Recorder, r.Log.WithValues("policy", policy.Name))
+	return handler.reconcile(ctx, policy, model, func(output *service.PolicyReconcileOutput, reconcileError error) {
+		policy.Status = r.ConverterToModel.ConvertFromServiceOutput(policy.Status, policy.Generation, output, reconcileError)
+	})
+
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AccessPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&accessv1.AccessPolicy{}).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
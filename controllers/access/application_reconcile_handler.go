@@ -0,0 +1,237 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/controllers/access/converter"
+	"bitbucket.org/accezz-io/sac-operator/model"
+	"bitbucket.org/accezz-io/sac-operator/service"
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
+	"bitbucket.org/accezz-io/sac-operator/utils/typederror"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// applicationReconcileHandler holds the reconcile steps shared by all the application kinds: it drives the
+// ApplicationService of the application tenant with the converted model, manages the finalizer and writes back the
+// application status.
+type applicationReconcileHandler struct {
+	client.Client
+	sacClients *sac.SecureAccessCloudClientRegistry
+	recorder   record.EventRecorder
+	// the interval in which a reconciled application is reconciled again, in order to detect drifts made in
+	// Secure-Access-Cloud. Zero disables the periodic reconcile.
+	resyncInterval time.Duration
+	log            logr.Logger
+}
+
+func newApplicationReconcileHandler(client client.Client, sacClients *sac.SecureAccessCloudClientRegistry, recorder record.EventRecorder, resyncInterval time.Duration, log logr.Logger) *applicationReconcileHandler {
+	return &applicationReconcileHandler{Client: client, sacClients: sacClients, recorder: recorder, resyncInterval: resyncInterval, log: log}
+}
+
+// reconcile reconciles the application model of the given object in Secure-Access-Cloud. setStatus is called
+// with the reconcile output and error in order to update the object status, including its conditions, before it is
+// written back to the cluster.
+func (h *applicationReconcileHandler) reconcile(ctx context.Context, object client.Object, application *model.Application, setStatus func(output *service.ApplicationReconcileOutput, reconcileError error)) (ctrl.Result, error) {
+
+	sacClient, err := h.sacClients.Get(application.TenantName)
+	if err != nil {
+		output := &service.ApplicationReconcileOutput{SACApplicationID: application.ID}
+		if application.ToDelete && errors.Is(err, sac.ErrorTenantNotFound) {
+			if deleted, tenantErr := tenantDeleted(ctx, h.Client, application.TenantName); tenantErr != nil {
+				err = tenantErr
+			} else if deleted {
+				h.recorder.Eventf(object, corev1.EventTypeWarning, eventReasonTenantDeleted,
+					"tenant %s was deleted, application %s is left in Secure-Access-Cloud", application.TenantName, application.ID)
+				output.Deleted, err = true, nil
+			}
+		}
+		return h.handleReconcilerReturn(ctx, object, output, err, setStatus)
+	}
+
+	if !application.ToDelete {
+		if err := h.resolvePolicies(ctx, object.GetNamespace(), application); err != nil {
+			output := &service.ApplicationReconcileOutput{SACApplicationID: application.ID}
+			return h.handleReconcilerReturn(ctx, object, output, err, setStatus)
+		}
+	}
+
+	output, err := service.NewApplicationServiceImpl(sacClient, h.log).
+		SetEventRecorder(newObjectEventRecorder(h.recorder, object)).
+		Reconcile(ctx, application)
+	if !controllerutil.ContainsFinalizer(object, applicationFinalizerName) && output.SACApplicationID != "" {
+		controllerutil.AddFinalizer(object, applicationFinalizerName)
+		if err := h.Update(ctx, object); err != nil {
+			h.log.Info("failed to add finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+	return h.handleReconcilerReturn(ctx, object, output, err, setStatus)
+}
+
+// reconcileApplication reconciles an application object exposing the given Services, whose status is the common
+// application status: the spec is validated unless the application is being deleted, the ports of the Services are
+// resolved, then the application is converted to its model with the resolved ports, in the same order as the
+// Services, and reconciled. The ServiceAvailable condition and the internal address are reported in the status.
+func (h *applicationReconcileHandler) reconcileApplication(ctx context.Context, object client.Object, status *accessv1.CommonApplicationStatus, services []accessv1.Service, validate func() error, convertToModel func(servicePorts []*corev1.ServicePort) (*model.Application, error)) (ctrl.Result, error) {
+
+	statusConverter := &converter.CommonParamsConverter{}
+	var internalAddress, serviceReason, serviceMessage string
+	setStatus := func(output *service.ApplicationReconcileOutput, reconcileError error) {
+		*status = statusConverter.ConvertFromServiceOutput(*status, object.GetGeneration(), output, reconcileError)
+		if serviceReason != "" && len(services) > 0 {
+			statusConverter.SetServiceCondition(status, object.GetGeneration(), serviceReason, serviceMessage)
+		}
+		status.InternalAddress = internalAddress
+	}
+
+	// an invalid spec must not block the deletion of an already created application
+	if object.GetDeletionTimestamp().IsZero() {
+		if err := validate(); err != nil {
+			h.log.Error(err, "invalid application spec")
+			return h.reportInvalidSpec(ctx, object, status.Id, err, setStatus)
+		}
+	}
+
+	servicePorts, serviceReason, serviceMessage, err := resolveReferencedServices(ctx, h.Client, services, object.GetNamespace())
+	if err != nil {
+		h.log.Error(err, "unable to fetch the exposed services")
+		return ctrl.Result{}, err
+	}
+
+	application, err := convertToModel(servicePorts)
+	if err != nil {
+		h.log.Error(err, "convert to service model")
+		return ctrl.Result{}, nil
+	}
+	internalAddress = application.ConnectionSettings.InternalAddress
+
+	return h.reconcile(ctx, object, application, setStatus)
+}
+
+// reportInvalidSpec reports the validation error of the object spec in its status, as an unrecoverable error: the object
+// is not reconciled again until its spec changes.
+func (h *applicationReconcileHandler) reportInvalidSpec(ctx context.Context, object client.Object, applicationID string, validationError error, setStatus func(output *service.ApplicationReconcileOutput, reconcileError error)) (ctrl.Result, error) {
+	output := &service.ApplicationReconcileOutput{SACApplicationID: applicationID}
+	return h.handleReconcilerReturn(ctx, object, output, fmt.Errorf("%w invalid spec: %s", typederror.UnrecoverableError, validationError), setStatus)
+}
+
+func (h *applicationReconcileHandler) handleReconcilerReturn(ctx context.Context, object client.Object, output *service.ApplicationReconcileOutput, reconcileError error, setStatus func(output *service.ApplicationReconcileOutput, reconcileError error)) (ctrl.Result, error) {
+
+	if errors.Is(reconcileError, typederror.UnrecoverableError) {
+		h.log.Error(reconcileError, "got unrecoverable error, giving up...")
+		recordReconcileError(h.recorder, object, reconcileError, true)
+		// report the error in the status conditions, the object is not reconciled again until its spec changes
+		setStatus(output, reconcileError)
+		if err := h.Status().Update(ctx, object); err != nil {
+			h.log.Error(err, "failed to update application status")
+		}
+		return ctrl.Result{Requeue: false}, nil
+	}
+
+	if output.Deleted {
+		controllerutil.RemoveFinalizer(object, applicationFinalizerName)
+		if err := h.Update(ctx, object); err != nil {
+			h.log.Error(err, "failed to remove Finalizer from application")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	setStatus(output, reconcileError)
+
+	if reconcileError != nil {
+		h.log.Error(reconcileError, "failed to reconcile, trying to update last known status")
+		recordReconcileError(h.recorder, object, reconcileError, false)
+	}
+
+	err := h.Status().Update(ctx, object)
+	if err != nil {
+		h.log.Error(reconcileError, "failed to update application status, retrying in 5 seconds")
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, err
+	}
+
+	if reconcileError != nil {
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, reconcileError
+	}
+
+	return ctrl.Result{RequeueAfter: h.resyncInterval}, nil
+}
+
+// resolvePolicies resolves the SAC ids of the referenced policies which are managed by AccessPolicy and
+// ActivityPolicy objects in the application namespace. Policies which are not managed in the cluster are left to be
+// looked up by name.
+func (h *applicationReconcileHandler) resolvePolicies(ctx context.Context, namespace string, application *model.Application) error {
+
+	resolvedPoliciesIDs := map[model.PolicyReference]string{}
+	for _, name := range application.AccessPoliciesNames {
+		reference := model.PolicyReference{Type: model.AccessPolicy, Name: name}
+		if err := h.resolvePolicy(ctx, types.NamespacedName{Name: name, Namespace: namespace}, &accessv1.AccessPolicy{}, reference, resolvedPoliciesIDs); err != nil {
+			return fmt.Errorf("access policy %s: %w", name, err)
+		}
+	}
+	for _, name := range application.ActivityPoliciesNames {
+		reference := model.PolicyReference{Type: model.ActivityPolicy, Name: name}
+		if err := h.resolvePolicy(ctx, types.NamespacedName{Name: name, Namespace: namespace}, &accessv1.ActivityPolicy{}, reference, resolvedPoliciesIDs); err != nil {
+			return fmt.Errorf("activity policy %s: %w", name, err)
+		}
+	}
+
+	application.ResolvedPoliciesIDs = resolvedPoliciesIDs
+	return nil
+}
+
+func (h *applicationReconcileHandler) resolvePolicy(ctx context.Context, key types.NamespacedName, policy client.Object, reference model.PolicyReference, resolvedPoliciesIDs map[model.PolicyReference]string) error {
+
+	if err := h.Get(ctx, key, policy); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	// the application is reconciled again once the policy is created, see watchReferencedPolicies
+	id := policyID(policy)
+	if id == "" {
+		return fmt.Errorf("was not created in Secure-Access-Cloud yet")
+	}
+
+	resolvedPoliciesIDs[reference] = id
+	return nil
+}
+
+// setupApplicationController sets up the controller of an application kind with the Manager. The applications are
+// indexed by the policies and the Services they reference, services being nil for a kind which does not expose
+// Services. The generation predicate is applied only to the applications, the policies are watched for their
+// creation and the Services for any change.
+func setupApplicationController(mgr ctrl.Manager, r reconcile.Reconciler, application client.Object, applications client.ObjectList, commonParams func(application client.Object) accessv1.CommonApplicationParams, services func(application client.Object) []accessv1.Service, log logr.Logger) error {
+	if err := indexReferencedPolicies(mgr, application, commonParams); err != nil {
+		return err
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(application, builder.WithPredicates(predicate.GenerationChangedPredicate{}))
+	if services != nil {
+		if err := indexReferencedServices(mgr, application, services); err != nil {
+			return err
+		}
+		b = watchReferencedServices(b, mgr.GetClient(), applications, log)
+	}
+	return watchReferencedPolicies(b, mgr.GetClient(), applications, log).
+		Complete(r)
+}
@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"bitbucket.org/accezz-io/sac-operator/utils"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+)
+
+type AccessPolicyConverter struct {
+	*CommonPolicyConverter
+}
+
+func NewAccessPolicyConverter() *AccessPolicyConverter {
+	return &AccessPolicyConverter{
+		&CommonPolicyConverter{},
+	}
+}
+
+func (a *AccessPolicyConverter) ConvertToModel(policy *accessv1.AccessPolicy) *model.Policy {
+
+	targetProtocol := policy.Spec.TargetProtocol
+	if targetProtocol == "" {
+		targetProtocol = model.HTTP
+	}
+
+	return &model.Policy{
+		ID:                policy.Status.Id,
+		Name:              policy.Name,
+		TenantName:        policy.Spec.TenantRef,
+		Type:              model.AccessPolicy,
+		TargetProtocol:    targetProtocol,
+		Enabled:           utils.Convert_Pointer_bool_To_bool_with_default(policy.Spec.Enabled, true),
+		ToDelete:          !policy.ObjectMeta.DeletionTimestamp.IsZero(),
+		DirectoryEntities: a.convertDirectoryEntities(policy.Spec.DirectoryEntities),
+		FilterConditions:  a.convertFilterConditions(policy.Spec.FilterConditions),
+	}
+}
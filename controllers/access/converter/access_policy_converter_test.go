@@ -0,0 +1,70 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+)
+
+func TestAccessPolicyConverter_ConvertToModel(t *testing.T) {
+	disabled := false
+	tests := []struct {
+		name     string
+		policy   *accessv1.AccessPolicy
+		expected *model.Policy
+	}{
+		{
+			name: "defaults flow",
+			policy: &accessv1.AccessPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "only-devops"},
+			},
+			expected: &model.Policy{
+				Name:           "only-devops",
+				Type:           model.AccessPolicy,
+				TargetProtocol: model.HTTP,
+				Enabled:        true,
+			},
+		},
+		{
+			name: "happy flow",
+			policy: &accessv1.AccessPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "only-devops"},
+				Spec: accessv1.AccessPolicySpec{
+					TargetProtocol: model.SSH,
+					Enabled:        &disabled,
+					DirectoryEntities: []accessv1.DirectoryEntity{
+						{IdentifierInProvider: "devops", IdentityProviderID: "idp", IdentityProviderType: "local", Type: "Group"},
+					},
+					FilterConditions: []accessv1.FilterCondition{
+						{Type: "IP_UTILS_COUNTRY", Arguments: map[string][]string{"countries": {"US"}}},
+					},
+				},
+				Status: accessv1.CommonPolicyStatus{Id: "uuid"},
+			},
+			expected: &model.Policy{
+				ID:             "uuid",
+				Name:           "only-devops",
+				Type:           model.AccessPolicy,
+				TargetProtocol: model.SSH,
+				Enabled:        false,
+				DirectoryEntities: []model.DirectoryEntity{
+					{IdentifierInProvider: "devops", IdentityProviderID: "idp", IdentityProviderType: "local", Type: "Group"},
+				},
+				FilterConditions: []model.FilterCondition{
+					{Type: "IP_UTILS_COUNTRY", Positive: true, Arguments: map[string][]string{"countries": {"US"}}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewAccessPolicyConverter()
+			assert.Equal(t, tt.expected, a.ConvertToModel(tt.policy))
+		})
+	}
+}
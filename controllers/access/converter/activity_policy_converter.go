@@ -0,0 +1,50 @@
+package converter
+
+import (
+	"bitbucket.org/accezz-io/sac-operator/utils"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+)
+
+type ActivityPolicyConverter struct {
+	*CommonPolicyConverter
+}
+
+func NewActivityPolicyConverter() *ActivityPolicyConverter {
+	return &ActivityPolicyConverter{
+		&CommonPolicyConverter{},
+	}
+}
+
+func (a *ActivityPolicyConverter) ConvertToModel(policy *accessv1.ActivityPolicy) *model.Policy {
+
+	targetProtocol := policy.Spec.TargetProtocol
+	if targetProtocol == "" {
+		targetProtocol = model.HTTP
+	}
+
+	output := &model.Policy{
+		ID:                policy.Status.Id,
+		Name:              policy.Name,
+		TenantName:        policy.Spec.TenantRef,
+		Type:              model.ActivityPolicy,
+		TargetProtocol:    targetProtocol,
+		Enabled:           utils.Convert_Pointer_bool_To_bool_with_default(policy.Spec.Enabled, true),
+		ToDelete:          !policy.ObjectMeta.DeletionTimestamp.IsZero(),
+		DirectoryEntities: a.convertDirectoryEntities(policy.Spec.DirectoryEntities),
+	}
+
+	for _, rule := range policy.Spec.Rules {
+		policyRule := model.PolicyRule{Action: rule.Action}
+		for _, condition := range rule.Conditions {
+			policyRule.Conditions = append(policyRule.Conditions, model.PolicyRuleCondition{Type: condition.Type, Arguments: condition.Arguments})
+		}
+		for _, target := range rule.Targets {
+			policyRule.Targets = append(policyRule.Targets, model.PolicyRuleTarget{Type: target.Type, Arguments: target.Arguments})
+		}
+		output.Rules = append(output.Rules, policyRule)
+	}
+
+	return output
+}
@@ -0,0 +1,62 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+)
+
+func TestActivityPolicyConverter_ConvertToModel(t *testing.T) {
+	// given
+	policy := &accessv1.ActivityPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "block-deletes"},
+		Spec: accessv1.ActivityPolicySpec{
+			TargetProtocol: model.SSH,
+			DirectoryEntities: []accessv1.DirectoryEntity{
+				{IdentifierInProvider: "contractors", IdentityProviderID: "idp", IdentityProviderType: "local", Type: "Group"},
+			},
+			Rules: []accessv1.ActivityRule{
+				{
+					Action:     "BLOCK",
+					Conditions: []accessv1.ActivityRuleCondition{{Type: "MANAGED_DEVICE"}},
+					Targets:    []accessv1.ActivityRuleTarget{{Type: "METHOD", Arguments: map[string][]string{"methods": {"DELETE"}}}},
+				},
+				{
+					Action: "ALLOW",
+				},
+			},
+		},
+		Status: accessv1.CommonPolicyStatus{Id: "uuid"},
+	}
+
+	// when
+	a := NewActivityPolicyConverter()
+	got := a.ConvertToModel(policy)
+
+	// then
+	assert.Equal(t, &model.Policy{
+		ID:             "uuid",
+		Name:           "block-deletes",
+		Type:           model.ActivityPolicy,
+		TargetProtocol: model.SSH,
+		Enabled:        true,
+		DirectoryEntities: []model.DirectoryEntity{
+			{IdentifierInProvider: "contractors", IdentityProviderID: "idp", IdentityProviderType: "local", Type: "Group"},
+		},
+		Rules: []model.PolicyRule{
+			{
+				Action:     "BLOCK",
+				Conditions: []model.PolicyRuleCondition{{Type: "MANAGED_DEVICE"}},
+				Targets:    []model.PolicyRuleTarget{{Type: "METHOD", Arguments: map[string][]string{"methods": {"DELETE"}}}},
+			},
+			{
+				Action: "ALLOW",
+			},
+		},
+	}, got)
+}
@@ -0,0 +1,159 @@
+package converter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"bitbucket.org/accezz-io/sac-operator/utils"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+	"bitbucket.org/accezz-io/sac-operator/service"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type CommonParamsConverter struct{}
+
+func (c *CommonParamsConverter) copyCommonParams(params accessv1.CommonApplicationParams, applicationParams *model.CommonApplicationParams) error {
+	applicationParams.IsVisible = utils.Convert_Pointer_bool_To_bool_with_default(params.IsVisible, true)
+	applicationParams.Enabled = utils.Convert_Pointer_bool_To_bool_with_default(params.Enabled, true)
+	applicationParams.IsNotificationEnabled = utils.Convert_Pointer_bool_To_bool_with_default(params.IsNotificationEnabled, false)
+	applicationParams.SiteName = params.SiteName
+	applicationParams.TenantName = params.TenantRef
+	applicationParams.AccessPoliciesNames = params.AccessPoliciesNames
+	applicationParams.ActivityPoliciesNames = params.ActivityPoliciesNames
+	applicationParams.DriftPolicy = params.DriftPolicy
+
+	return nil
+}
+
+// convertToInternalAddress converts the service to the internal address of the application. servicePort is the port of
+// the referenced Service resolved by the controller, when it is nil the service port and schema are used as written in
+// the spec.
+func (c *CommonParamsConverter) convertToInternalAddress(applicationType model.ApplicationType, service accessv1.Service, servicePort *corev1.ServicePort, applicationNamespace string) string {
+
+	port := convertToPort(service, servicePort)
+	schema := convertToSchema(applicationType, service, servicePort, port)
+	host := c.convertToServiceHost(service, applicationNamespace)
+	if port == "" {
+		return fmt.Sprintf("%s://%s", schema, host)
+	}
+	return fmt.Sprintf("%s://%s:%s", schema, host, port)
+}
+
+// convertToPort returns the number of the resolved servicePort, or the port as written in the spec when it was not
+// resolved.
+func convertToPort(service accessv1.Service, servicePort *corev1.ServicePort) string {
+	if servicePort == nil {
+		return service.Port
+	}
+	return strconv.Itoa(int(servicePort.Port))
+}
+
+func (c *CommonParamsConverter) convertToServiceHost(service accessv1.Service, applicationNamespace string) string {
+
+	namespace := applicationNamespace
+	if service.Namespace != "" {
+		namespace = service.Namespace
+	}
+	return fmt.Sprintf("%s.%s", service.Name, namespace)
+}
+
+// ConvertFromServiceOutput converts the reconcile output of the given spec generation, and the reconcile error, to the
+// application status. The conditions of the current status are carried over in order to keep their transition times.
+// The generation is observed only once reconciled successfully, so a failed spec change is still seen as a spec change
+// when it is retried, rather than as a drift.
+func (c *CommonParamsConverter) ConvertFromServiceOutput(current accessv1.CommonApplicationStatus, generation int64, output *service.ApplicationReconcileOutput, reconcileError error) accessv1.CommonApplicationStatus {
+	status := accessv1.CommonApplicationStatus{
+		Id:                 output.SACApplicationID,
+		ModifiedOn:         current.ModifiedOn,
+		ObservedGeneration: current.ObservedGeneration,
+		Drift:              output.Drift,
+		Conditions:         current.Conditions,
+	}
+	if reconcileError == nil {
+		status.ModifiedOn = metav1.Now()
+		status.ObservedGeneration = generation
+	}
+
+	setApplicationConditions(&status, generation, output, reconcileError)
+
+	return status
+}
+
+func validateService(service accessv1.Service) error {
+
+	if service.Name == "" {
+		return fmt.Errorf("service name cannot be empty")
+	}
+	if service.Port == "" {
+		return fmt.Errorf("service port cannot be empty")
+	}
+
+	return nil
+}
+
+// convertToSchema returns the schema of the service, in order of precedence: the schema set in the spec, the
+// appProtocol or the name of the resolved Service port (http applications only), and finally a guess based on the
+// port number.
+func convertToSchema(applicationType model.ApplicationType, service accessv1.Service, servicePort *corev1.ServicePort, port string) string {
+	if service.Schema != "" {
+		return service.Schema
+	}
+
+	switch applicationType {
+	case model.SSH, model.DynamicSSH, model.RDP, model.TCP:
+		return "tcp"
+	case model.HTTP:
+		{
+			if schema := convertServicePortToSchema(servicePort); schema != "" {
+				return schema
+			}
+			switch port {
+			case "443", "8443":
+				return "https"
+			default:
+				return "http"
+			}
+		}
+	default:
+		return "http"
+	}
+}
+
+// convertServicePortToSchema returns the http schema declared by the Service port appProtocol (e.g. https or
+// kubernetes.io/h2c) or, following the common <protocol>[-<suffix>] naming convention, by the port name. An empty
+// schema is returned when none is declared.
+func convertServicePortToSchema(servicePort *corev1.ServicePort) string {
+	if servicePort == nil {
+		return ""
+	}
+
+	if servicePort.AppProtocol != nil {
+		appProtocol := strings.ToLower(*servicePort.AppProtocol)
+		appProtocol = appProtocol[strings.LastIndex(appProtocol, "/")+1:]
+		if isHttpSchema(appProtocol) {
+			return appProtocol
+		}
+	}
+
+	name := strings.ToLower(servicePort.Name)
+	if i := strings.Index(name, "-"); i >= 0 {
+		name = name[:i]
+	}
+	if isHttpSchema(name) {
+		return name
+	}
+	return ""
+}
+
+func isHttpSchema(protocol string) bool {
+	switch protocol {
+	case "http", "https", "h2c", "grpc":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,78 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+)
+
+func TestCommonParamsConverter_convertToInternalAddress(t *testing.T) {
+	https := "https"
+	h2c := "kubernetes.io/h2c"
+	tests := []struct {
+		name            string
+		applicationType model.ApplicationType
+		service         accessv1.Service
+		servicePort     *corev1.ServicePort
+		expected        string
+	}{
+		{
+			name:            "unresolved port",
+			applicationType: model.HTTP,
+			service:         accessv1.Service{Name: "web", Port: "8443"},
+			expected:        "https://web.apps:8443",
+		},
+		{
+			name:            "named port resolved to its number",
+			applicationType: model.HTTP,
+			service:         accessv1.Service{Name: "web", Port: "web"},
+			servicePort:     &corev1.ServicePort{Name: "web", Port: 8080},
+			expected:        "http://web.apps:8080",
+		},
+		{
+			name:            "schema from the app protocol",
+			applicationType: model.HTTP,
+			service:         accessv1.Service{Name: "web", Port: "web"},
+			servicePort:     &corev1.ServicePort{Name: "web", Port: 8080, AppProtocol: &https},
+			expected:        "https://web.apps:8080",
+		},
+		{
+			name:            "schema from a prefixed app protocol",
+			applicationType: model.HTTP,
+			service:         accessv1.Service{Name: "web", Port: "web"},
+			servicePort:     &corev1.ServicePort{Name: "web", Port: 8080, AppProtocol: &h2c},
+			expected:        "h2c://web.apps:8080",
+		},
+		{
+			name:            "schema from the port name",
+			applicationType: model.HTTP,
+			service:         accessv1.Service{Name: "web", Port: "grpc-api"},
+			servicePort:     &corev1.ServicePort{Name: "grpc-api", Port: 9000},
+			expected:        "grpc://web.apps:9000",
+		},
+		{
+			name:            "schema from the spec",
+			applicationType: model.HTTP,
+			service:         accessv1.Service{Name: "web", Port: "web", Schema: "http"},
+			servicePort:     &corev1.ServicePort{Name: "web", Port: 8080, AppProtocol: &https},
+			expected:        "http://web.apps:8080",
+		},
+		{
+			name:            "tcp application ignores the app protocol",
+			applicationType: model.TCP,
+			service:         accessv1.Service{Name: "db", Port: "postgres"},
+			servicePort:     &corev1.ServicePort{Name: "postgres", Port: 5432, AppProtocol: &https},
+			expected:        "tcp://db.apps:5432",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &CommonParamsConverter{}
+			assert.Equal(t, tt.expected, c.convertToInternalAddress(tt.applicationType, tt.service, tt.servicePort, "apps"))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package converter
+
+import (
+	"bitbucket.org/accezz-io/sac-operator/utils"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+	"bitbucket.org/accezz-io/sac-operator/service"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type CommonPolicyConverter struct{}
+
+func (c *CommonPolicyConverter) convertDirectoryEntities(directoryEntities []accessv1.DirectoryEntity) []model.DirectoryEntity {
+	var output []model.DirectoryEntity
+	for _, directoryEntity := range directoryEntities {
+		output = append(output, model.DirectoryEntity{
+			IdentifierInProvider: directoryEntity.IdentifierInProvider,
+			IdentityProviderID:   directoryEntity.IdentityProviderID,
+			IdentityProviderType: directoryEntity.IdentityProviderType,
+			Type:                 directoryEntity.Type,
+			DisplayName:          directoryEntity.DisplayName,
+		})
+	}
+	return output
+}
+
+func (c *CommonPolicyConverter) convertFilterConditions(filterConditions []accessv1.FilterCondition) []model.FilterCondition {
+	var output []model.FilterCondition
+	for _, filterCondition := range filterConditions {
+		output = append(output, model.FilterCondition{
+			Type:      filterCondition.Type,
+			Positive:  utils.Convert_Pointer_bool_To_bool_with_default(filterCondition.Positive, true),
+			Arguments: filterCondition.Arguments,
+		})
+	}
+	return output
+}
+
+// ConvertFromServiceOutput converts the reconcile output of the given spec generation, and the reconcile error, to the
+// policy status. The conditions of the current status are carried over in order to keep their transition times, and
+// the generation is observed only once reconciled successfully.
+func (c *CommonPolicyConverter) ConvertFromServiceOutput(current accessv1.CommonPolicyStatus, generation int64, output *service.PolicyReconcileOutput, reconcileError error) accessv1.CommonPolicyStatus {
+	status := accessv1.CommonPolicyStatus{
+		Id:                 output.SACPolicyID,
+		ModifiedOn:         current.ModifiedOn,
+		ObservedGeneration: current.ObservedGeneration,
+		Conditions:         current.Conditions,
+	}
+	if reconcileError == nil {
+		status.ModifiedOn = metav1.Now()
+		status.ObservedGeneration = generation
+	}
+
+	setPolicyConditions(&status, generation, reconcileError)
+
+	return status
+}
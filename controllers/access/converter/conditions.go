@@ -0,0 +1,164 @@
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/service"
+	"bitbucket.org/accezz-io/sac-operator/utils/typederror"
+)
+
+// conditionsSetter sets the conditions of an object status for the given spec generation. meta.SetStatusCondition
+// keeps the transition time of the conditions whose status did not change.
+type conditionsSetter struct {
+	conditions *[]metav1.Condition
+	generation int64
+}
+
+func (c *conditionsSetter) set(conditionType string, status bool, reason, message string) {
+	conditionStatus := metav1.ConditionFalse
+	if status {
+		conditionStatus = metav1.ConditionTrue
+	}
+	meta.SetStatusCondition(c.conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             conditionStatus,
+		ObservedGeneration: c.generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// setReconcileResult sets the Ready and Degraded conditions, ready is false when the reconcile failed or when
+// notReadyMessage is not empty.
+func (c *conditionsSetter) setReconcileResult(reconcileError error, notReadyReason, notReadyMessage string) {
+	switch {
+	case reconcileError != nil:
+		reason := errorReason(reconcileError)
+		c.set(accessv1.ConditionReady, false, reason, reconcileError.Error())
+		c.set(accessv1.ConditionDegraded, true, reason, reconcileError.Error())
+	case notReadyMessage != "":
+		c.set(accessv1.ConditionReady, false, notReadyReason, notReadyMessage)
+		c.set(accessv1.ConditionDegraded, false, accessv1.ReasonReconciled, "")
+	default:
+		c.set(accessv1.ConditionReady, true, accessv1.ReasonReconciled, "")
+		c.set(accessv1.ConditionDegraded, false, accessv1.ReasonReconciled, "")
+	}
+}
+
+func setApplicationConditions(status *accessv1.CommonApplicationStatus, generation int64, output *service.ApplicationReconcileOutput, reconcileError error) {
+	c := &conditionsSetter{conditions: &status.Conditions, generation: generation}
+
+	if reconcileError == nil && len(output.Drift) > 0 {
+		// the application was left as found in Secure-Access-Cloud, the site and policies bindings were not verified
+		c.setDrift(output.Drift)
+		return
+	}
+
+	if reconcileError == nil && output.SACApplicationID != "" {
+		c.set(accessv1.ConditionSynced, true, accessv1.ReasonReconciled, "")
+	} else {
+		c.set(accessv1.ConditionSynced, false, errorReason(reconcileError), errorMessage(reconcileError))
+	}
+
+	if output.SiteBound {
+		c.set(accessv1.ConditionSiteBound, true, accessv1.ReasonSiteBound, "")
+	} else {
+		c.set(accessv1.ConditionSiteBound, false, accessv1.ReasonSiteNotBound, errorMessage(reconcileError))
+	}
+
+	if output.PoliciesBound {
+		c.set(accessv1.ConditionPoliciesBound, true, accessv1.ReasonPoliciesBound, "")
+	} else {
+		c.set(accessv1.ConditionPoliciesBound, false, accessv1.ReasonPoliciesNotBound, errorMessage(reconcileError))
+	}
+
+	c.setReconcileResult(reconcileError, "", "")
+}
+
+// SetServiceCondition sets the ServiceAvailable condition of the application with the reason returned by checking the
+// exposed Service. An application whose Service is unavailable is not Ready, even if it was reconciled in
+// Secure-Access-Cloud.
+func (c *CommonParamsConverter) SetServiceCondition(status *accessv1.CommonApplicationStatus, generation int64, reason, message string) {
+	setter := &conditionsSetter{conditions: &status.Conditions, generation: generation}
+
+	if reason == accessv1.ReasonServiceAvailable {
+		setter.set(accessv1.ConditionServiceAvailable, true, reason, "")
+		return
+	}
+
+	setter.set(accessv1.ConditionServiceAvailable, false, reason, message)
+	if meta.IsStatusConditionTrue(status.Conditions, accessv1.ConditionReady) {
+		setter.set(accessv1.ConditionReady, false, reason, message)
+	}
+}
+
+func setSiteConditions(status *accessv1.SiteStatus, generation int64, desiredConnectors int, reconcileError error) {
+	c := &conditionsSetter{conditions: &status.Conditions, generation: generation}
+
+	drifted := reconcileError == nil && len(status.Drift) > 0
+	switch {
+	case drifted:
+		c.setDrift(status.Drift)
+	case reconcileError == nil && status.ID != "":
+		c.set(accessv1.ConditionSynced, true, accessv1.ReasonReconciled, "")
+	default:
+		c.set(accessv1.ConditionSynced, false, errorReason(reconcileError), errorMessage(reconcileError))
+	}
+
+	connectorsMessage := fmt.Sprintf("%d/%d connectors are healthy", status.NumberOfHealthyConnectors, desiredConnectors)
+	connectorsAvailable := status.NumberOfHealthyConnectors >= desiredConnectors
+	if connectorsAvailable {
+		c.set(accessv1.ConditionConnectorsAvailable, true, accessv1.ReasonConnectorsAvailable, connectorsMessage)
+	} else {
+		c.set(accessv1.ConditionConnectorsAvailable, false, accessv1.ReasonConnectorsUnavailable, connectorsMessage)
+	}
+
+	switch {
+	case drifted:
+		// Ready was already set with the drift
+	case connectorsAvailable:
+		c.setReconcileResult(reconcileError, "", "")
+	default:
+		c.setReconcileResult(reconcileError, accessv1.ReasonConnectorsUnavailable, connectorsMessage)
+	}
+}
+
+func setPolicyConditions(status *accessv1.CommonPolicyStatus, generation int64, reconcileError error) {
+	c := &conditionsSetter{conditions: &status.Conditions, generation: generation}
+
+	if reconcileError == nil && status.Id != "" {
+		c.set(accessv1.ConditionSynced, true, accessv1.ReasonReconciled, "")
+	} else {
+		c.set(accessv1.ConditionSynced, false, errorReason(reconcileError), errorMessage(reconcileError))
+	}
+
+	c.setReconcileResult(reconcileError, "", "")
+}
+
+// setDrift sets the Synced and Ready conditions of an object whose drift was only reported.
+func (c *conditionsSetter) setDrift(drift []string) {
+	message := "drifted in Secure-Access-Cloud: " + strings.Join(drift, ", ")
+	c.set(accessv1.ConditionSynced, false, accessv1.ReasonDriftDetected, message)
+	c.set(accessv1.ConditionReady, false, accessv1.ReasonDriftDetected, message)
+	c.set(accessv1.ConditionDegraded, false, accessv1.ReasonReconciled, "")
+}
+
+func errorReason(err error) string {
+	if errors.Is(err, typederror.UnrecoverableError) {
+		return accessv1.ReasonUnrecoverableError
+	}
+	return accessv1.ReasonReconcileFailed
+}
+
+func errorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,175 @@
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+	"bitbucket.org/accezz-io/sac-operator/service"
+	"bitbucket.org/accezz-io/sac-operator/utils/typederror"
+)
+
+func clearTransitionTimes(conditions []metav1.Condition) {
+	for i := range conditions {
+		conditions[i].LastTransitionTime = metav1.Time{}
+	}
+}
+
+func TestCommonParamsConverter_ConvertFromServiceOutput(t *testing.T) {
+	unrecoverableError := fmt.Errorf("%w site my-site does not exist", typederror.UnrecoverableError)
+	tests := []struct {
+		name                       string
+		output                     *service.ApplicationReconcileOutput
+		reconcileError             error
+		expected                   []metav1.Condition
+		expectedObservedGeneration int64
+	}{
+		{
+			name:                       "reconciled",
+			output:                     &service.ApplicationReconcileOutput{SACApplicationID: "uuid", SiteBound: true, PoliciesBound: true},
+			expectedObservedGeneration: 3,
+			expected: []metav1.Condition{
+				{Type: accessv1.ConditionSynced, Status: metav1.ConditionTrue, ObservedGeneration: 3, Reason: accessv1.ReasonReconciled},
+				{Type: accessv1.ConditionSiteBound, Status: metav1.ConditionTrue, ObservedGeneration: 3, Reason: accessv1.ReasonSiteBound},
+				{Type: accessv1.ConditionPoliciesBound, Status: metav1.ConditionTrue, ObservedGeneration: 3, Reason: accessv1.ReasonPoliciesBound},
+				{Type: accessv1.ConditionReady, Status: metav1.ConditionTrue, ObservedGeneration: 3, Reason: accessv1.ReasonReconciled},
+				{Type: accessv1.ConditionDegraded, Status: metav1.ConditionFalse, ObservedGeneration: 3, Reason: accessv1.ReasonReconciled},
+			},
+		},
+		{
+			name:                       "drift reported",
+			output:                     &service.ApplicationReconcileOutput{SACApplicationID: "uuid", Drift: []string{"connectionSettings.internalAddress"}},
+			expectedObservedGeneration: 3,
+			expected: []metav1.Condition{
+				{Type: accessv1.ConditionSynced, Status: metav1.ConditionFalse, ObservedGeneration: 3, Reason: accessv1.ReasonDriftDetected, Message: "drifted in Secure-Access-Cloud: connectionSettings.internalAddress"},
+				{Type: accessv1.ConditionReady, Status: metav1.ConditionFalse, ObservedGeneration: 3, Reason: accessv1.ReasonDriftDetected, Message: "drifted in Secure-Access-Cloud: connectionSettings.internalAddress"},
+				{Type: accessv1.ConditionDegraded, Status: metav1.ConditionFalse, ObservedGeneration: 3, Reason: accessv1.ReasonReconciled},
+			},
+		},
+		{
+			name:                       "unrecoverable error",
+			output:                     &service.ApplicationReconcileOutput{},
+			reconcileError:             unrecoverableError,
+			expectedObservedGeneration: 2,
+			expected: []metav1.Condition{
+				{Type: accessv1.ConditionSynced, Status: metav1.ConditionFalse, ObservedGeneration: 3, Reason: accessv1.ReasonUnrecoverableError, Message: unrecoverableError.Error()},
+				{Type: accessv1.ConditionSiteBound, Status: metav1.ConditionFalse, ObservedGeneration: 3, Reason: accessv1.ReasonSiteNotBound, Message: unrecoverableError.Error()},
+				{Type: accessv1.ConditionPoliciesBound, Status: metav1.ConditionFalse, ObservedGeneration: 3, Reason: accessv1.ReasonPoliciesNotBound, Message: unrecoverableError.Error()},
+				{Type: accessv1.ConditionReady, Status: metav1.ConditionFalse, ObservedGeneration: 3, Reason: accessv1.ReasonUnrecoverableError, Message: unrecoverableError.Error()},
+				{Type: accessv1.ConditionDegraded, Status: metav1.ConditionTrue, ObservedGeneration: 3, Reason: accessv1.ReasonUnrecoverableError, Message: unrecoverableError.Error()},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &CommonParamsConverter{}
+			status := c.ConvertFromServiceOutput(accessv1.CommonApplicationStatus{ObservedGeneration: 2}, 3, tt.output, tt.reconcileError)
+			clearTransitionTimes(status.Conditions)
+			assert.Equal(t, tt.expectedObservedGeneration, status.ObservedGeneration)
+			assert.Equal(t, tt.expected, status.Conditions)
+		})
+	}
+}
+
+func TestCommonParamsConverter_ConvertFromServiceOutput_KeepsTransitionTime(t *testing.T) {
+	// given
+	c := &CommonParamsConverter{}
+	output := &service.ApplicationReconcileOutput{SACApplicationID: "uuid", SiteBound: true, PoliciesBound: true}
+	current := c.ConvertFromServiceOutput(accessv1.CommonApplicationStatus{}, 1, output, nil)
+	transitionTime := metav1.NewTime(current.ModifiedOn.Add(-time.Hour))
+	meta.FindStatusCondition(current.Conditions, accessv1.ConditionReady).LastTransitionTime = transitionTime
+
+	// when
+	status := c.ConvertFromServiceOutput(current, 2, output, nil)
+
+	// then
+	ready := meta.FindStatusCondition(status.Conditions, accessv1.ConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, transitionTime, ready.LastTransitionTime)
+	assert.Equal(t, int64(2), ready.ObservedGeneration)
+}
+
+func TestCommonParamsConverter_ConvertFromServiceOutput_RetriedSpecChange(t *testing.T) {
+	// given a spec change of an application whose drift is only reported
+	c := NewTcpApplicationTypeConverter()
+	application := &accessv1.TcpApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-database", Namespace: "apps", Generation: 2},
+		Spec: accessv1.TcpApplicationSpec{
+			CommonApplicationParams: accessv1.CommonApplicationParams{SiteName: "my-site", DriftPolicy: model.DriftPolicyReport},
+			Targets:                 []accessv1.TcpTarget{{Host: "db.example.com", Ports: []string{"5432"}}},
+		},
+		Status: accessv1.CommonApplicationStatus{Id: "uuid", ObservedGeneration: 1},
+	}
+	output := &service.ApplicationReconcileOutput{SACApplicationID: "uuid"}
+
+	// when its update fails
+	application.Status = c.ConvertFromServiceOutput(application.Status, application.Generation, output, errors.New("server error"))
+
+	// then it is retried as a spec change, rather than reported as a drift
+	retried, err := c.ConvertToModel(application)
+	require.NoError(t, err)
+	assert.True(t, retried.SpecChanged)
+	assert.Equal(t, model.DriftPolicyReport, retried.DriftPolicy)
+
+	// when the retry succeeds
+	application.Status = c.ConvertFromServiceOutput(application.Status, application.Generation, output, nil)
+
+	// then the spec change was applied
+	resynced, err := c.ConvertToModel(application)
+	require.NoError(t, err)
+	assert.False(t, resynced.SpecChanged)
+}
+
+func TestCommonParamsConverter_SetServiceCondition(t *testing.T) {
+	tests := []struct {
+		name          string
+		reason        string
+		message       string
+		expectedReady metav1.Condition
+	}{
+		{
+			name:          "service available",
+			reason:        accessv1.ReasonServiceAvailable,
+			expectedReady: metav1.Condition{Type: accessv1.ConditionReady, Status: metav1.ConditionTrue, ObservedGeneration: 3, Reason: accessv1.ReasonReconciled},
+		},
+		{
+			name:          "service not found",
+			reason:        accessv1.ReasonServiceNotFound,
+			message:       "service apps/my-service was not found",
+			expectedReady: metav1.Condition{Type: accessv1.ConditionReady, Status: metav1.ConditionFalse, ObservedGeneration: 3, Reason: accessv1.ReasonServiceNotFound, Message: "service apps/my-service was not found"},
+		},
+		{
+			name:          "service port not found",
+			reason:        accessv1.ReasonServicePortNotFound,
+			message:       "service apps/my-service has no port 8080",
+			expectedReady: metav1.Condition{Type: accessv1.ConditionReady, Status: metav1.ConditionFalse, ObservedGeneration: 3, Reason: accessv1.ReasonServicePortNotFound, Message: "service apps/my-service has no port 8080"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			c := &CommonParamsConverter{}
+			output := &service.ApplicationReconcileOutput{SACApplicationID: "uuid", SiteBound: true, PoliciesBound: true}
+			status := c.ConvertFromServiceOutput(accessv1.CommonApplicationStatus{}, 3, output, nil)
+
+			// when
+			c.SetServiceCondition(&status, 3, tt.reason, tt.message)
+			clearTransitionTimes(status.Conditions)
+
+			// then
+			serviceAvailable := meta.FindStatusCondition(status.Conditions, accessv1.ConditionServiceAvailable)
+			require.NotNil(t, serviceAvailable)
+			assert.Equal(t, tt.reason, serviceAvailable.Reason)
+			assert.Equal(t, tt.message, serviceAvailable.Message)
+			assert.Equal(t, tt.expectedReady, *meta.FindStatusCondition(status.Conditions, accessv1.ConditionReady))
+		})
+	}
+}
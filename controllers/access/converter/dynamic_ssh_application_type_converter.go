@@ -0,0 +1,131 @@
+package converter
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"bitbucket.org/accezz-io/sac-operator/service"
+	"bitbucket.org/accezz-io/sac-operator/utils"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const defaultSshPort = "22"
+
+type DynamicSshApplicationTypeConverter struct {
+	*CommonParamsConverter
+}
+
+func NewDynamicSshApplicationTypeConverter() *DynamicSshApplicationTypeConverter {
+	return &DynamicSshApplicationTypeConverter{
+		&CommonParamsConverter{},
+	}
+}
+
+func (a *DynamicSshApplicationTypeConverter) Validate(application *accessv1.DynamicSshApplication) error {
+
+	selector, err := metav1.LabelSelectorAsSelector(&application.Spec.Selector)
+	if err != nil {
+		return fmt.Errorf("invalid selector: %w", err)
+	}
+	if selector.Empty() {
+		return fmt.Errorf("selector cannot be empty")
+	}
+	if application.Spec.Port != "" {
+		if _, err := parsePort(application.Spec.Port); err != nil {
+			return fmt.Errorf("invalid port: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ConvertToModel converts the DynamicSshApplication into a dynamic SSH application model targeting the given pods.
+// Only running pods with an assigned IP are targeted.
+func (a *DynamicSshApplicationTypeConverter) ConvertToModel(application *accessv1.DynamicSshApplication, pods []corev1.Pod) (*model.Application, error) {
+
+	output := &model.Application{
+		ID:       application.Status.Id,
+		Type:     model.DynamicSSH,
+		ToDelete: !application.ObjectMeta.DeletionTimestamp.IsZero(),
+		// the targets follow the selected pods, a change of the selected pods is a desired change as well
+		SpecChanged: application.Generation != application.Status.ObservedGeneration ||
+			!reflect.DeepEqual(a.ConvertToTargets(pods), application.Status.Targets),
+		ConnectionSettings: &model.ConnectionSettings{},
+	}
+
+	port := application.Spec.Port
+	if port == "" {
+		port = defaultSshPort
+	}
+	for _, pod := range sortedTargetPods(pods) {
+		output.TcpTunnelSettings = append(output.TcpTunnelSettings, model.TcpTunnelSetting{
+			Target: pod.Status.PodIP,
+			Ports:  []string{port},
+		})
+	}
+
+	if application.Spec.SshSettings != nil {
+		output.SshSettings = &model.SshSettings{
+			UserAccounts:        application.Spec.SshSettings.UserAccounts,
+			FullUPNAutoGenerate: utils.Convert_Pointer_bool_To_bool_with_default(application.Spec.SshSettings.FullUPNAutoGenerate, false),
+			UPNAutoGenerate:     utils.Convert_Pointer_bool_To_bool_with_default(application.Spec.SshSettings.UPNAutoGenerate, false),
+			EmailAutoGenerate:   utils.Convert_Pointer_bool_To_bool_with_default(application.Spec.SshSettings.EmailAutoGenerate, false),
+		}
+	}
+
+	commonParams := model.CommonApplicationParams{
+		Name: application.Name,
+	}
+	err := a.copyCommonParams(application.Spec.CommonApplicationParams, &commonParams)
+	if err != nil {
+		return output, err
+	}
+
+	output.CommonApplicationParams = commonParams
+
+	return output, nil
+}
+
+// ConvertToStatus converts the reconcile result of the application targeting the given pods to its status. Like the
+// observed generation, the targets are only updated once they were reconciled successfully, so that a change of the
+// selected pods which failed is retried as a desired change rather than detected as a drift.
+func (a *DynamicSshApplicationTypeConverter) ConvertToStatus(current accessv1.DynamicSshApplicationStatus, generation int64, pods []corev1.Pod, output *service.ApplicationReconcileOutput, reconcileError error) accessv1.DynamicSshApplicationStatus {
+	status := accessv1.DynamicSshApplicationStatus{
+		CommonApplicationStatus: a.ConvertFromServiceOutput(current.CommonApplicationStatus, generation, output, reconcileError),
+		Targets:                 current.Targets,
+	}
+	if reconcileError == nil {
+		status.Targets = a.ConvertToTargets(pods)
+	}
+	return status
+}
+
+// ConvertToTargets returns the status representation of the pods targeted by the application.
+func (a *DynamicSshApplicationTypeConverter) ConvertToTargets(pods []corev1.Pod) []string {
+	var targets []string
+	for _, pod := range sortedTargetPods(pods) {
+		targets = append(targets, fmt.Sprintf("%s/%s", pod.Name, pod.Status.PodIP))
+	}
+	return targets
+}
+
+// sortedTargetPods filters out the pods which cannot be reached yet (or anymore) and sorts the rest by name, so that
+// the same set of pods is always converted into the same target list.
+func sortedTargetPods(pods []corev1.Pod) []corev1.Pod {
+	var targetPods []corev1.Pod
+	for _, pod := range pods {
+		if pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" || !pod.DeletionTimestamp.IsZero() {
+			continue
+		}
+		targetPods = append(targetPods, pod)
+	}
+	sort.Slice(targetPods, func(i, j int) bool {
+		return targetPods[i].Name < targetPods[j].Name
+	})
+	return targetPods
+}
@@ -0,0 +1,149 @@
+package converter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+	"bitbucket.org/accezz-io/sac-operator/service"
+)
+
+func TestDynamicSshApplicationTypeConverter_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    accessv1.DynamicSshApplicationSpec
+		wantErr bool
+	}{
+		{
+			name: "match labels selector",
+			spec: accessv1.DynamicSshApplicationSpec{Selector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "debug"}}},
+		},
+		{
+			name:    "empty selector",
+			spec:    accessv1.DynamicSshApplicationSpec{},
+			wantErr: true,
+		},
+		{
+			name: "invalid selector",
+			spec: accessv1.DynamicSshApplicationSpec{Selector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: "Unknown"},
+			}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid port",
+			spec:    accessv1.DynamicSshApplicationSpec{Selector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "debug"}}, Port: "ssh"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewDynamicSshApplicationTypeConverter()
+			err := a.Validate(&accessv1.DynamicSshApplication{Spec: tt.spec})
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDynamicSshApplicationTypeConverter_ConvertToModel(t *testing.T) {
+	// given
+	application := &accessv1.DynamicSshApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "debug-pods", Namespace: "sre"},
+		Spec: accessv1.DynamicSshApplicationSpec{
+			CommonApplicationParams: accessv1.CommonApplicationParams{SiteName: "my-site"},
+			Selector:                metav1.LabelSelector{MatchLabels: map[string]string{"app": "debug"}},
+			SshSettings:             &accessv1.SshSettings{UserAccounts: []string{"root"}},
+		},
+		Status: accessv1.DynamicSshApplicationStatus{CommonApplicationStatus: accessv1.CommonApplicationStatus{Id: "uuid"}},
+	}
+	now := metav1.Now()
+	pods := []corev1.Pod{
+		newPod("debug-b", corev1.PodRunning, "10.0.0.2"),
+		newPod("debug-a", corev1.PodRunning, "10.0.0.1"),
+		newPod("debug-pending", corev1.PodPending, ""),
+		newPod("debug-failed", corev1.PodFailed, "10.0.0.3"),
+	}
+	terminating := newPod("debug-terminating", corev1.PodRunning, "10.0.0.4")
+	terminating.DeletionTimestamp = &now
+	pods = append(pods, terminating)
+
+	// when
+	a := NewDynamicSshApplicationTypeConverter()
+	got, err := a.ConvertToModel(application, pods)
+	targets := a.ConvertToTargets(pods)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, &model.Application{
+		ID:          "uuid",
+		Type:        model.DynamicSSH,
+		SpecChanged: true, // the targets changed since the last reconcile
+		CommonApplicationParams: model.CommonApplicationParams{
+			Name:      "debug-pods",
+			SiteName:  "my-site",
+			IsVisible: true,
+			Enabled:   true,
+		},
+		ConnectionSettings: &model.ConnectionSettings{},
+		SshSettings:        &model.SshSettings{UserAccounts: []string{"root"}},
+		TcpTunnelSettings: []model.TcpTunnelSetting{
+			{Target: "10.0.0.1", Ports: []string{"22"}},
+			{Target: "10.0.0.2", Ports: []string{"22"}},
+		},
+	}, got)
+	assert.Equal(t, []string{"debug-a/10.0.0.1", "debug-b/10.0.0.2"}, targets)
+}
+
+func TestDynamicSshApplicationTypeConverter_ConvertToStatus_RetriedTargetsChange(t *testing.T) {
+	// given a pod selected by an application whose drift is only reported
+	a := NewDynamicSshApplicationTypeConverter()
+	application := &accessv1.DynamicSshApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "debug-pods", Namespace: "sre", Generation: 1},
+		Spec: accessv1.DynamicSshApplicationSpec{
+			CommonApplicationParams: accessv1.CommonApplicationParams{SiteName: "my-site", DriftPolicy: model.DriftPolicyReport},
+			Selector:                metav1.LabelSelector{MatchLabels: map[string]string{"app": "debug"}},
+		},
+		Status: accessv1.DynamicSshApplicationStatus{
+			CommonApplicationStatus: accessv1.CommonApplicationStatus{Id: "uuid", ObservedGeneration: 1},
+			Targets:                 []string{"debug-a/10.0.0.1"},
+		},
+	}
+	pods := []corev1.Pod{newPod("debug-a", corev1.PodRunning, "10.0.0.1"), newPod("debug-b", corev1.PodRunning, "10.0.0.2")}
+	output := &service.ApplicationReconcileOutput{SACApplicationID: "uuid"}
+
+	// when its update fails
+	application.Status = a.ConvertToStatus(application.Status, application.Generation, pods, output, errors.New("server error"))
+
+	// then the targets change is retried as a desired change, rather than reported as a drift
+	assert.Equal(t, []string{"debug-a/10.0.0.1"}, application.Status.Targets)
+	retried, err := a.ConvertToModel(application, pods)
+	require.NoError(t, err)
+	assert.True(t, retried.SpecChanged)
+
+	// when the retry succeeds
+	application.Status = a.ConvertToStatus(application.Status, application.Generation, pods, output, nil)
+
+	// then the targets change was applied
+	assert.Equal(t, []string{"debug-a/10.0.0.1", "debug-b/10.0.0.2"}, application.Status.Targets)
+	resynced, err := a.ConvertToModel(application, pods)
+	require.NoError(t, err)
+	assert.False(t, resynced.SpecChanged)
+}
+
+func newPod(name string, phase corev1.PodPhase, ip string) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "sre"},
+		Status:     corev1.PodStatus{Phase: phase, PodIP: ip},
+	}
+}
@@ -0,0 +1,124 @@
+package converter
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+)
+
+// The annotations of the kubernetes objects (Ingresses and Services) which are exposed in Secure-Access-Cloud by
+// generated HttpApplications.
+const (
+	// ExposeAnnotation exposes the object when set to "true"
+	ExposeAnnotation = "access.secure-access-cloud.symantec.com/expose"
+	// SiteAnnotation is the site the applications are bound to (required)
+	SiteAnnotation = "access.secure-access-cloud.symantec.com/site"
+	// TenantAnnotation is the SecureAccessCloudTenant the applications are created in
+	TenantAnnotation = "access.secure-access-cloud.symantec.com/tenant"
+	// AccessPoliciesAnnotation is a comma separated list of the access policies of the applications
+	AccessPoliciesAnnotation = "access.secure-access-cloud.symantec.com/access-policies"
+	// ActivityPoliciesAnnotation is a comma separated list of the activity policies of the applications
+	ActivityPoliciesAnnotation = "access.secure-access-cloud.symantec.com/activity-policies"
+)
+
+func isExposedByAnnotation(object metav1.Object) bool {
+	return object.GetAnnotations()[ExposeAnnotation] == "true"
+}
+
+// convertExposeAnnotations converts the annotations of the object to the common params of its applications.
+func convertExposeAnnotations(object metav1.Object) (accessv1.CommonApplicationParams, error) {
+	return convertAnnotationsToCommonParams(object.GetAnnotations(), object.GetNamespace()+"/"+object.GetName())
+}
+
+// convertAnnotationsToCommonParams converts the expose annotations to the common params of the applications, the
+// site annotation is required. objectKey identifies the annotated object in the returned error.
+func convertAnnotationsToCommonParams(annotations map[string]string, objectKey string) (accessv1.CommonApplicationParams, error) {
+
+	if annotations[SiteAnnotation] == "" {
+		return accessv1.CommonApplicationParams{}, fmt.Errorf("%s is missing the %s annotation", objectKey, SiteAnnotation)
+	}
+
+	return accessv1.CommonApplicationParams{
+		SiteName:              annotations[SiteAnnotation],
+		TenantRef:             annotations[TenantAnnotation],
+		AccessPoliciesNames:   splitAnnotation(annotations[AccessPoliciesAnnotation]),
+		ActivityPoliciesNames: splitAnnotation(annotations[ActivityPoliciesAnnotation]),
+	}, nil
+}
+
+// convertHostToConnectionSettings converts the host of a generated application to its sub type and connection
+// settings. A host with a TLS secret is exposed on a custom domain (or a wildcard domain for a wildcard host) with
+// the secret as its certificate, any other host is exposed on the tenant domain with a subdomain derived from the
+// whole host.
+func convertHostToConnectionSettings(host, tlsSecretName string) (model.ApplicationSubType, *accessv1.HttpConnectionSettings) {
+
+	if tlsSecretName != "" {
+		subType := model.ApplicationSubType(model.CustomDomain)
+		if strings.HasPrefix(host, "*.") {
+			subType = model.WildCardDomain
+		}
+		return subType, &accessv1.HttpConnectionSettings{CustomExternalAddress: host, CustomSSLCertificateSecretName: tlsSecretName}
+	}
+
+	connectionSettings := &accessv1.HttpConnectionSettings{}
+	if host != "" {
+		connectionSettings.SubDomain = hostSubDomain(host)
+	}
+	return model.AutoGeneratedDomain, connectionSettings
+}
+
+// maxSubDomainLength is the maximal length of a DNS label
+const maxSubDomainLength = 63
+
+// hostSubDomain derives the subdomain of a host from all its labels, e.g. app-a-com for app.a.com, so that the same
+// name in different domains is not exposed on the same subdomain. A subdomain longer than a DNS label is truncated and
+// suffixed with a hash of the host in order to stay unique.
+func hostSubDomain(host string) string {
+	subDomain := strings.ToLower(strings.ReplaceAll(strings.TrimPrefix(host, "*."), ".", "-"))
+	if len(subDomain) <= maxSubDomainLength {
+		return subDomain
+	}
+
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(host))
+	suffix := fmt.Sprintf("-%08x", hash.Sum32())
+	return strings.TrimRight(subDomain[:maxSubDomainLength-len(suffix)], "-") + suffix
+}
+
+// generatedApplicationName names an application generated from the owner after the owner and a hash of its host and
+// backend service, so the application keeps its name when other hosts and backends are added or removed.
+func generatedApplicationName(ownerName, host string, service accessv1.Service) string {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(host + "/" + service.Namespace + "/" + service.Name + ":" + service.Port))
+	return fmt.Sprintf("%s-%08x", ownerName, hash.Sum32())
+}
+
+// sortedApplications returns the applications sorted by their names.
+func sortedApplications(applications map[string]accessv1.HttpApplication) []accessv1.HttpApplication {
+	names := make([]string, 0, len(applications))
+	for name := range applications {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	output := make([]accessv1.HttpApplication, 0, len(names))
+	for _, name := range names {
+		output = append(output, applications[name])
+	}
+	return output
+}
+
+func splitAnnotation(value string) []string {
+	var output []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			output = append(output, item)
+		}
+	}
+	return output
+}
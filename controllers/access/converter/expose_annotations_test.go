@@ -0,0 +1,53 @@
+package converter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostSubDomain(t *testing.T) {
+	longHost := strings.Repeat("a", 40) + "." + strings.Repeat("b", 40) + ".example.com"
+	tests := []struct {
+		name     string
+		host     string
+		expected string
+	}{
+		{
+			name:     "host",
+			host:     "app.a.com",
+			expected: "app-a-com",
+		},
+		{
+			name:     "same name in another domain",
+			host:     "app.b.com",
+			expected: "app-b-com",
+		},
+		{
+			name:     "wildcard host",
+			host:     "*.Example.com",
+			expected: "example-com",
+		},
+		{
+			name:     "single label host",
+			host:     "shop",
+			expected: "shop",
+		},
+		{
+			name:     "host longer than a DNS label",
+			host:     longHost,
+			expected: strings.Repeat("a", 40) + "-" + strings.Repeat("b", 13) + "-aacdab16",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			subDomain := hostSubDomain(tt.host)
+
+			// then
+			assert.Equal(t, tt.expected, subDomain)
+			assert.LessOrEqual(t, len(subDomain), maxSubDomainLength)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package converter
+
+import (
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+)
+
+const (
+	// ServiceNameLabel is set on the generated HttpApplications with the name of their Service
+	ServiceNameLabel = "access.secure-access-cloud.symantec.com/service"
+
+	// ServicePortAnnotation is the name or the number of the exposed port of the Service (default is its first port)
+	ServicePortAnnotation = "access.secure-access-cloud.symantec.com/port"
+	// SubDomainAnnotation is the subdomain of the application in the tenant domain
+	SubDomainAnnotation = "access.secure-access-cloud.symantec.com/subdomain"
+)
+
+// ExposedServiceConverter converts a Service annotated to be exposed to the HttpApplication exposing it in
+// Secure-Access-Cloud
+type ExposedServiceConverter struct{}
+
+func NewExposedServiceConverter() *ExposedServiceConverter {
+	return &ExposedServiceConverter{}
+}
+
+// IsExposed returns whether the Service is annotated to be exposed.
+func (c *ExposedServiceConverter) IsExposed(service *corev1.Service) bool {
+	return isExposedByAnnotation(service)
+}
+
+// ConvertToHttpApplication converts the Service to an HttpApplication with the same name, exposing the annotated
+// port of the Service.
+func (c *ExposedServiceConverter) ConvertToHttpApplication(service *corev1.Service) (*accessv1.HttpApplication, error) {
+
+	commonParams, err := convertExposeAnnotations(service)
+	if err != nil {
+		return nil, err
+	}
+
+	port := service.Annotations[ServicePortAnnotation]
+	if port == "" {
+		if len(service.Spec.Ports) == 0 {
+			return nil, fmt.Errorf("service %s/%s has no ports to expose", service.Namespace, service.Name)
+		}
+		port = service.Spec.Ports[0].Name
+		if port == "" {
+			port = strconv.Itoa(int(service.Spec.Ports[0].Port))
+		}
+	}
+
+	application := &accessv1.HttpApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      service.Name,
+			Namespace: service.Namespace,
+			Labels:    map[string]string{ServiceNameLabel: service.Name},
+		},
+		Spec: accessv1.HttpApplicationSpec{
+			CommonApplicationParams: commonParams,
+			SubType:                 model.AutoGeneratedDomain,
+			Service:                 accessv1.Service{Name: service.Name, Port: port},
+			HttpConnectionSettings:  &accessv1.HttpConnectionSettings{SubDomain: service.Annotations[SubDomainAnnotation]},
+		},
+	}
+
+	return application, nil
+}
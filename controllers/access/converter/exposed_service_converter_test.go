@@ -0,0 +1,86 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+)
+
+func TestExposedServiceConverter_ConvertToHttpApplication(t *testing.T) {
+	ports := []corev1.ServicePort{{Port: 80}, {Name: "admin", Port: 8080}}
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		ports       []corev1.ServicePort
+		expected    accessv1.HttpApplicationSpec
+		wantErr     bool
+	}{
+		{
+			name: "first port",
+			annotations: map[string]string{
+				ExposeAnnotation:           "true",
+				SiteAnnotation:             "my-site",
+				ActivityPoliciesAnnotation: "audit",
+			},
+			ports: ports,
+			expected: accessv1.HttpApplicationSpec{
+				CommonApplicationParams: accessv1.CommonApplicationParams{SiteName: "my-site", ActivityPoliciesNames: []string{"audit"}},
+				SubType:                 model.AutoGeneratedDomain,
+				Service:                 accessv1.Service{Name: "grafana", Port: "80"},
+				HttpConnectionSettings:  &accessv1.HttpConnectionSettings{},
+			},
+		},
+		{
+			name: "annotated port and subdomain",
+			annotations: map[string]string{
+				ExposeAnnotation:      "true",
+				SiteAnnotation:        "my-site",
+				TenantAnnotation:      "staging",
+				ServicePortAnnotation: "admin",
+				SubDomainAnnotation:   "grafana-admin",
+			},
+			ports: ports,
+			expected: accessv1.HttpApplicationSpec{
+				CommonApplicationParams: accessv1.CommonApplicationParams{SiteName: "my-site", TenantRef: "staging"},
+				SubType:                 model.AutoGeneratedDomain,
+				Service:                 accessv1.Service{Name: "grafana", Port: "admin"},
+				HttpConnectionSettings:  &accessv1.HttpConnectionSettings{SubDomain: "grafana-admin"},
+			},
+		},
+		{
+			name:        "missing site",
+			annotations: map[string]string{ExposeAnnotation: "true"},
+			ports:       ports,
+			wantErr:     true,
+		},
+		{
+			name:        "no ports",
+			annotations: map[string]string{ExposeAnnotation: "true", SiteAnnotation: "my-site"},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "grafana", Namespace: "monitoring", Annotations: tt.annotations},
+				Spec:       corev1.ServiceSpec{Ports: tt.ports},
+			}
+			got, err := NewExposedServiceConverter().ConvertToHttpApplication(service)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "grafana", got.Name)
+			assert.Equal(t, "monitoring", got.Namespace)
+			assert.Equal(t, map[string]string{ServiceNameLabel: "grafana"}, got.Labels)
+			assert.Equal(t, tt.expected, got.Spec)
+		})
+	}
+}
@@ -2,52 +2,18 @@ package converter
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
 	"bitbucket.org/accezz-io/sac-operator/utils"
 
 	"github.com/jinzhu/copier"
+	corev1 "k8s.io/api/core/v1"
 
 	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
 	"bitbucket.org/accezz-io/sac-operator/model"
-	"bitbucket.org/accezz-io/sac-operator/service"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-type CommonParamsConverter struct{}
-
-func (c *CommonParamsConverter) copyCommonParams(params accessv1.CommonApplicationParams, applicationParams *model.CommonApplicationParams) error {
-	applicationParams.IsVisible = utils.Convert_Pointer_bool_To_bool_with_default(params.IsVisible, true)
-	applicationParams.Enabled = utils.Convert_Pointer_bool_To_bool_with_default(params.Enabled, true)
-	applicationParams.IsNotificationEnabled = utils.Convert_Pointer_bool_To_bool_with_default(params.IsNotificationEnabled, false)
-	applicationParams.SiteName = params.SiteName
-	applicationParams.AccessPoliciesNames = params.AccessPoliciesNames
-	applicationParams.ActivityPoliciesNames = params.ActivityPoliciesNames
-
-	return nil
-}
-
-func convertToSchema(applicationType model.ApplicationType, service accessv1.Service) string {
-	if service.Schema != "" {
-		return service.Schema
-	}
-
-	switch applicationType {
-	case model.SSH, model.DynamicSSH, model.RDP, model.TCP:
-		return "tcp"
-	case model.HTTP:
-		{
-			switch service.Port {
-			case "443", "8443":
-				return "https"
-			default:
-				return "http"
-			}
-		}
-	default:
-		return "http"
-	}
-}
-
 type HttpApplicationTypeConverter struct {
 	*CommonParamsConverter
 }
@@ -58,27 +24,63 @@ func NewHttpApplicationTypeConverter() *HttpApplicationTypeConverter {
 	}
 }
 
+// headerNameRegexp matches the valid http header names, i.e. the tokens of RFC 7230
+var headerNameRegexp = regexp.MustCompile("^[!#$%&'*+\\-.^_`|~0-9A-Za-z]+$")
+
+// Validate validates the fields of the application: the service, the settings required by the sub type and the
+// customized header names.
 func (a *HttpApplicationTypeConverter) Validate(application *accessv1.HttpApplication) error {
 
-	if application.Spec.Service.Name == "" {
-		return fmt.Errorf("service name cannot be empty")
+	if err := validateService(application.Spec.Service); err != nil {
+		return err
+	}
+
+	connectionSettings := application.Spec.HttpConnectionSettings
+	if connectionSettings == nil {
+		connectionSettings = &accessv1.HttpConnectionSettings{}
 	}
-	if application.Spec.Service.Port == "" {
-		return fmt.Errorf("service port cannot be empty")
+	hasCertificateSecret := connectionSettings.CustomSSLCertificateSecretName != ""
+	switch subType := utils.GetApplicationSubTypeOrDefault(application.Spec.SubType, model.DefaultSubType); subType {
+	case model.CustomDomain:
+		if connectionSettings.CustomExternalAddress == "" {
+			return fmt.Errorf("connection_settings.custom_external_address is required for a %s application", subType)
+		}
+		if connectionSettings.CustomSSLCertificate == "" && !hasCertificateSecret {
+			return fmt.Errorf("connection_settings.custom_ssl_certificate or custom_ssl_certificate_secret_name is required for a %s application", subType)
+		}
+	case model.WildCardDomain:
+		if connectionSettings.CustomExternalAddress == "" {
+			return fmt.Errorf("connection_settings.custom_external_address is required for a %s application", subType)
+		}
+		if (connectionSettings.CustomSSLCertificate == "" || connectionSettings.WildcardPrivateKey == "") && !hasCertificateSecret {
+			return fmt.Errorf("connection_settings.custom_ssl_certificate and wildcard_private_key, or custom_ssl_certificate_secret_name, are required for a %s application", subType)
+		}
+	}
+
+	if application.Spec.HttpRequestCustomizationSettings != nil {
+		for name := range application.Spec.HttpRequestCustomizationSettings.HeaderCustomization {
+			if !headerNameRegexp.MatchString(name) {
+				return fmt.Errorf("request_customization_settings.header_customization: invalid header name %q", name)
+			}
+		}
 	}
 
 	return nil
 }
 
-func (a *HttpApplicationTypeConverter) ConvertToModel(application *accessv1.HttpApplication) (*model.Application, error) {
+// ConvertToModel converts the application to an HTTP application model. servicePort is the port of the exposed Service
+// which matches the spec, it is used to resolve a named port to its number and the schema to its appProtocol. When it
+// is nil the spec port and schema are used as written.
+func (a *HttpApplicationTypeConverter) ConvertToModel(application *accessv1.HttpApplication, servicePort *corev1.ServicePort) (*model.Application, error) {
 
 	output := &model.Application{
-		ID:       application.Status.Id,
-		Type:     model.HTTP,
-		SubType:  utils.GetApplicationSubTypeOrDefault(application.Spec.SubType, model.DefaultSubType),
-		ToDelete: !application.ObjectMeta.DeletionTimestamp.IsZero(),
+		ID:          application.Status.Id,
+		Type:        model.HTTP,
+		SubType:     utils.GetApplicationSubTypeOrDefault(application.Spec.SubType, model.DefaultSubType),
+		ToDelete:    !application.ObjectMeta.DeletionTimestamp.IsZero(),
+		SpecChanged: application.Generation != application.Status.ObservedGeneration,
 		ConnectionSettings: &model.ConnectionSettings{
-			InternalAddress: a.convertToInternalAddress(application.Spec.Service, application.Namespace),
+			InternalAddress: a.convertToInternalAddress(model.HTTP, application.Spec.Service, servicePort, application.Namespace),
 		},
 	}
 
@@ -121,22 +123,22 @@ func (a *HttpApplicationTypeConverter) ConvertToModel(application *accessv1.Http
 	return output, nil
 }
 
-func (a *HttpApplicationTypeConverter) convertToInternalAddress(service accessv1.Service, applicationNamespace string) string {
+// ConvertCertificateSecret sets the custom certificate of the application model from the kubernetes.io/tls secret
+// referenced by custom_ssl_certificate_secret_name. A wildcard domain takes the private key separately, while a custom
+// domain takes the certificate followed by its private key.
+func (a *HttpApplicationTypeConverter) ConvertCertificateSecret(application *model.Application, secret *corev1.Secret) error {
 
-	schema := convertToSchema(model.HTTP, service)
-	namespace := applicationNamespace
-	if service.Namespace != "" {
-		namespace = service.Namespace
-	}
-	if service.Port == "" {
-		return fmt.Sprintf("%s://%s.%s", schema, service.Name, namespace)
+	certificate, privateKey := secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey]
+	if len(certificate) == 0 || len(privateKey) == 0 {
+		return fmt.Errorf("secret %s/%s is missing the %s or %s key", secret.Namespace, secret.Name, corev1.TLSCertKey, corev1.TLSPrivateKeyKey)
 	}
-	return fmt.Sprintf("%s://%s.%s:%s", schema, service.Name, namespace, service.Port)
-}
 
-func (a HttpApplicationTypeConverter) ConvertFromServiceOutput(output *service.ApplicationReconcileOutput) accessv1.CommonApplicationStatus {
-	return accessv1.CommonApplicationStatus{
-		Id:         output.SACApplicationID,
-		ModifiedOn: metav1.Now(),
+	if application.SubType == model.WildCardDomain {
+		application.ConnectionSettings.CustomSSLCertificate = string(certificate)
+		application.ConnectionSettings.WildcardPrivateKey = string(privateKey)
+		return nil
 	}
+
+	application.ConnectionSettings.CustomSSLCertificate = strings.TrimRight(string(certificate), "\n") + "\n" + string(privateKey)
+	return nil
 }
@@ -0,0 +1,254 @@
+package converter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+)
+
+const (
+	// GatewayControllerName is the controller name of the GatewayClasses whose Gateways are managed by the operator
+	GatewayControllerName = "access.secure-access-cloud.symantec.com/gateway-controller"
+
+	// HTTPRouteNameLabel is set on the generated HttpApplications with the name of their HTTPRoute
+	HTTPRouteNameLabel = "access.secure-access-cloud.symantec.com/httproute"
+)
+
+// HTTPRouteConverter converts an HTTPRoute attached to a Gateway of the operator to the HttpApplications exposing its
+// backends in Secure-Access-Cloud
+type HTTPRouteConverter struct{}
+
+func NewHTTPRouteConverter() *HTTPRouteConverter {
+	return &HTTPRouteConverter{}
+}
+
+// ConvertToHttpApplications converts the HTTPRoute to one HttpApplication per hostname. A hostname matching an HTTPS
+// listener of the Gateway, whose certificate is in the route namespace, is exposed on a custom domain with the listener
+// certificate. The site, tenant and policies are taken from the expose annotations of the route, or of the Gateway when
+// the route has no site annotation. An application exposes a single Service on the domain of its hostname: the
+// backendRef of the highest weight of the rule matching the root path, else of the first rule. The other backendRefs
+// are returned as not exposed, and the backendRefs which cannot be exposed (e.g. not a Service or in another
+// namespace) as unresolved.
+func (c *HTTPRouteConverter) ConvertToHttpApplications(route *gatewayv1alpha2.HTTPRoute, gateway *gatewayv1alpha2.Gateway) (applications []accessv1.HttpApplication, unresolvedRefs []string, unexposedRefs []string, err error) {
+
+	annotations := gateway.Annotations
+	if route.Annotations[SiteAnnotation] != "" {
+		annotations = route.Annotations
+	}
+	commonParams, err := convertAnnotationsToCommonParams(annotations, route.Namespace+"/"+route.Name)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var backends []routeBackend
+	for ruleIndex, rule := range route.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			service, err := convertBackendRefToService(backendRef.BackendObjectReference, route.Namespace)
+			if err != nil {
+				unresolvedRefs = append(unresolvedRefs, err.Error())
+				continue
+			}
+			weight := int32(1)
+			if backendRef.Weight != nil {
+				weight = *backendRef.Weight
+			}
+			backends = append(backends, routeBackend{service: service, rule: ruleIndex, rootPath: matchesRootPath(rule), weight: weight})
+		}
+	}
+	if len(backends) == 0 {
+		return nil, unresolvedRefs, nil, nil
+	}
+
+	exposed := exposedRouteBackend(backends)
+	unexposed := map[accessv1.Service]bool{exposed.service: true}
+	for _, backend := range backends {
+		if !unexposed[backend.service] {
+			unexposed[backend.service] = true
+			unexposedRefs = append(unexposedRefs, backend.service.Name+":"+backend.service.Port)
+		}
+	}
+
+	hostnames := route.Spec.Hostnames
+	if len(hostnames) == 0 {
+		hostnames = []gatewayv1alpha2.Hostname{""}
+	}
+
+	generated := map[string]accessv1.HttpApplication{}
+	for _, hostname := range hostnames {
+		host := string(hostname)
+		name := generatedApplicationName(route.Name, host, exposed.service)
+		subType, connectionSettings := convertHostToConnectionSettings(host, listenerCertificate(gateway, host, route.Namespace))
+		generated[name] = accessv1.HttpApplication{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: route.Namespace,
+				Labels:    map[string]string{HTTPRouteNameLabel: route.Name},
+			},
+			Spec: accessv1.HttpApplicationSpec{
+				CommonApplicationParams: commonParams,
+				SubType:                 subType,
+				Service:                 exposed.service,
+				HttpConnectionSettings:  connectionSettings,
+			},
+		}
+	}
+
+	return sortedApplications(generated), unresolvedRefs, unexposedRefs, nil
+}
+
+// routeBackend is an exposable backendRef of an HTTPRoute rule.
+type routeBackend struct {
+	service  accessv1.Service
+	rule     int
+	rootPath bool
+	weight   int32
+}
+
+// exposedRouteBackend returns the backend of the highest weight of the first rule matching the root path, or of the
+// first rule when no rule matches it.
+func exposedRouteBackend(backends []routeBackend) routeBackend {
+	rule := backends[0].rule
+	for _, backend := range backends {
+		if backend.rootPath {
+			rule = backend.rule
+			break
+		}
+	}
+
+	var exposed *routeBackend
+	for i := range backends {
+		if backends[i].rule == rule && (exposed == nil || backends[i].weight > exposed.weight) {
+			exposed = &backends[i]
+		}
+	}
+	return *exposed
+}
+
+// matchesRootPath returns whether the rule matches the root path, a rule without matches matches all the paths.
+func matchesRootPath(rule gatewayv1alpha2.HTTPRouteRule) bool {
+	if len(rule.Matches) == 0 {
+		return true
+	}
+	for _, match := range rule.Matches {
+		if match.Path == nil || match.Path.Value == nil || *match.Path.Value == "/" {
+			return true
+		}
+	}
+	return false
+}
+
+// convertBackendRefToService converts a backendRef to the exposed service, only Services in the route namespace with
+// a port are supported.
+func convertBackendRefToService(ref gatewayv1alpha2.BackendObjectReference, routeNamespace string) (accessv1.Service, error) {
+
+	if (ref.Group != nil && *ref.Group != "") || (ref.Kind != nil && *ref.Kind != "Service") {
+		return accessv1.Service{}, fmt.Errorf("backendRef %s is not a Service", ref.Name)
+	}
+	if ref.Namespace != nil && string(*ref.Namespace) != routeNamespace {
+		return accessv1.Service{}, fmt.Errorf("backendRef %s/%s is not in the route namespace", *ref.Namespace, ref.Name)
+	}
+	if ref.Port == nil {
+		return accessv1.Service{}, fmt.Errorf("backendRef %s has no port", ref.Name)
+	}
+
+	return accessv1.Service{Name: string(ref.Name), Port: strconv.Itoa(int(*ref.Port))}, nil
+}
+
+// listenerCertificate returns the name of the certificate Secret of the first HTTPS listener of the Gateway whose
+// hostname matches the host, or an empty string when there is none in the route namespace.
+func listenerCertificate(gateway *gatewayv1alpha2.Gateway, host, routeNamespace string) string {
+
+	if host == "" {
+		return ""
+	}
+	for _, listener := range gateway.Spec.Listeners {
+		if listener.TLS == nil || len(listener.TLS.CertificateRefs) == 0 || listener.Hostname == nil {
+			continue
+		}
+		if !hostnameMatches(string(*listener.Hostname), host) {
+			continue
+		}
+		ref := listener.TLS.CertificateRefs[0]
+		namespace := gateway.Namespace
+		if ref.Namespace != nil {
+			namespace = string(*ref.Namespace)
+		}
+		if namespace == routeNamespace {
+			return string(ref.Name)
+		}
+	}
+	return ""
+}
+
+// hostnameMatches returns whether the host matches the listener hostname, which may be a wildcard.
+func hostnameMatches(listenerHostname, host string) bool {
+	if listenerHostname == host {
+		return true
+	}
+	if strings.HasPrefix(listenerHostname, "*.") {
+		return strings.HasSuffix(host, listenerHostname[1:]) && !strings.HasPrefix(host, "*.")
+	}
+	return false
+}
+
+// The reasons of the HTTPRoute conditions which are not reported by the generated applications.
+const (
+	RouteReasonAccepted       = "Accepted"
+	RouteReasonPending        = "Pending"
+	RouteReasonResolvedRefs   = "ResolvedRefs"
+	RouteReasonInvalidBackend = "InvalidBackend"
+	RouteReasonInvalidRoute   = "InvalidRoute"
+	RouteReasonNoBackends     = "NoBackends"
+)
+
+// ConvertToRouteConditions converts the reconcile result of the applications generated from an HTTPRoute to the
+// conditions of the route parent status. The route is Accepted once all of its applications were reconciled in
+// Secure-Access-Cloud, and its refs are resolved once all of its backendRefs are exposable Services which exist and
+// have the referenced port. The transition times of the current conditions are kept.
+func (c *HTTPRouteConverter) ConvertToRouteConditions(current []metav1.Condition, generation int64, applications []accessv1.HttpApplication, unresolvedRefs []string, reconcileError error) []metav1.Condition {
+
+	conditions := append([]metav1.Condition{}, current...)
+	setter := &conditionsSetter{conditions: &conditions, generation: generation}
+	accepted, resolvedRefs := string(gatewayv1alpha2.ConditionRouteAccepted), string(gatewayv1alpha2.ConditionRouteResolvedRefs)
+
+	if reconcileError != nil {
+		setter.set(accepted, false, RouteReasonInvalidRoute, reconcileError.Error())
+	} else {
+		setter.set(accepted, true, RouteReasonAccepted, "")
+		for _, application := range applications {
+			synced := meta.FindStatusCondition(application.Status.Conditions, accessv1.ConditionSynced)
+			if synced == nil {
+				setter.set(accepted, false, RouteReasonPending, fmt.Sprintf("application %s was not reconciled yet", application.Name))
+				break
+			}
+			if synced.Status != metav1.ConditionTrue {
+				setter.set(accepted, false, synced.Reason, fmt.Sprintf("application %s: %s", application.Name, synced.Message))
+				break
+			}
+		}
+		if len(applications) == 0 && len(unresolvedRefs) == 0 {
+			setter.set(accepted, false, RouteReasonNoBackends, "the route has no backendRefs")
+		}
+	}
+
+	setter.set(resolvedRefs, true, RouteReasonResolvedRefs, "")
+	if len(unresolvedRefs) > 0 {
+		setter.set(resolvedRefs, false, RouteReasonInvalidBackend, strings.Join(unresolvedRefs, ", "))
+	} else {
+		for _, application := range applications {
+			serviceAvailable := meta.FindStatusCondition(application.Status.Conditions, accessv1.ConditionServiceAvailable)
+			if serviceAvailable != nil && serviceAvailable.Status != metav1.ConditionTrue {
+				setter.set(resolvedRefs, false, serviceAvailable.Reason, serviceAvailable.Message)
+				break
+			}
+		}
+	}
+
+	return conditions
+}
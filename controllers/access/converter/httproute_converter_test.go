@@ -0,0 +1,252 @@
+package converter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+)
+
+func httpBackendRef(name string, port gatewayv1alpha2.PortNumber) gatewayv1alpha2.HTTPBackendRef {
+	return gatewayv1alpha2.HTTPBackendRef{BackendRef: gatewayv1alpha2.BackendRef{
+		BackendObjectReference: gatewayv1alpha2.BackendObjectReference{Name: gatewayv1alpha2.ObjectName(name), Port: &port},
+	}}
+}
+
+func TestHTTPRouteConverter_ConvertToHttpApplications(t *testing.T) {
+	// given
+	wildcard := gatewayv1alpha2.Hostname("*.example.com")
+	gateway := &gatewayv1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "sac",
+			Namespace:   "apps",
+			Annotations: map[string]string{SiteAnnotation: "my-site"},
+		},
+		Spec: gatewayv1alpha2.GatewaySpec{
+			Listeners: []gatewayv1alpha2.Listener{{
+				Name:     "https",
+				Hostname: &wildcard,
+				Protocol: gatewayv1alpha2.HTTPSProtocolType,
+				TLS: &gatewayv1alpha2.GatewayTLSConfig{
+					CertificateRefs: []*gatewayv1alpha2.SecretObjectReference{{Name: "wildcard-tls"}},
+				},
+			}},
+		},
+	}
+	otherNamespace := gatewayv1alpha2.Namespace("other-apps")
+	route := &gatewayv1alpha2.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: "apps"},
+		Spec: gatewayv1alpha2.HTTPRouteSpec{
+			Hostnames: []gatewayv1alpha2.Hostname{"shop.example.com", "shop.internal.corp"},
+			Rules: []gatewayv1alpha2.HTTPRouteRule{{
+				BackendRefs: []gatewayv1alpha2.HTTPBackendRef{
+					httpBackendRef("shop", 8080),
+					{BackendRef: gatewayv1alpha2.BackendRef{BackendObjectReference: gatewayv1alpha2.BackendObjectReference{Name: "shop", Namespace: &otherNamespace}}},
+				},
+			}},
+		},
+	}
+
+	// when
+	got, unresolvedRefs, unexposedRefs, err := NewHTTPRouteConverter().ConvertToHttpApplications(route, gateway)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backendRef other-apps/shop is not in the route namespace"}, unresolvedRefs)
+	assert.Empty(t, unexposedRefs)
+	require.Len(t, got, 2)
+	hosts := map[string]accessv1.HttpApplicationSpec{}
+	for _, application := range got {
+		assert.Equal(t, map[string]string{HTTPRouteNameLabel: "shop"}, application.Labels)
+		hosts[application.Spec.HttpConnectionSettings.CustomExternalAddress+application.Spec.HttpConnectionSettings.SubDomain] = application.Spec
+	}
+	assert.Equal(t, accessv1.HttpApplicationSpec{
+		CommonApplicationParams: accessv1.CommonApplicationParams{SiteName: "my-site"},
+		SubType:                 model.CustomDomain,
+		Service:                 accessv1.Service{Name: "shop", Port: "8080"},
+		HttpConnectionSettings: &accessv1.HttpConnectionSettings{
+			CustomExternalAddress:          "shop.example.com",
+			CustomSSLCertificateSecretName: "wildcard-tls",
+		},
+	}, hosts["shop.example.com"])
+	assert.Equal(t, accessv1.HttpApplicationSpec{
+		CommonApplicationParams: accessv1.CommonApplicationParams{SiteName: "my-site"},
+		SubType:                 model.AutoGeneratedDomain,
+		Service:                 accessv1.Service{Name: "shop", Port: "8080"},
+		HttpConnectionSettings:  &accessv1.HttpConnectionSettings{SubDomain: "shop-internal-corp"},
+	}, hosts["shop-internal-corp"])
+}
+
+func TestHTTPRouteConverter_ConvertToHttpApplications_RouteAnnotations(t *testing.T) {
+	// given a route which overrides the site of its gateway
+	gateway := &gatewayv1alpha2.Gateway{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{SiteAnnotation: "my-site"}}}
+	route := &gatewayv1alpha2.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: "apps", Annotations: map[string]string{SiteAnnotation: "other-site", AccessPoliciesAnnotation: "devops"}},
+		Spec: gatewayv1alpha2.HTTPRouteSpec{
+			Rules: []gatewayv1alpha2.HTTPRouteRule{{BackendRefs: []gatewayv1alpha2.HTTPBackendRef{httpBackendRef("shop", 80)}}},
+		},
+	}
+
+	// when
+	got, _, _, err := NewHTTPRouteConverter().ConvertToHttpApplications(route, gateway)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, accessv1.CommonApplicationParams{SiteName: "other-site", AccessPoliciesNames: []string{"devops"}}, got[0].Spec.CommonApplicationParams)
+	assert.Equal(t, &accessv1.HttpConnectionSettings{}, got[0].Spec.HttpConnectionSettings)
+}
+
+func TestHTTPRouteConverter_ConvertToHttpApplications_MultipleBackendRefs(t *testing.T) {
+	weighted := func(name string, weight int32) gatewayv1alpha2.HTTPBackendRef {
+		backendRef := httpBackendRef(name, 80)
+		backendRef.Weight = &weight
+		return backendRef
+	}
+	pathMatch := func(path string) []gatewayv1alpha2.HTTPRouteMatch {
+		return []gatewayv1alpha2.HTTPRouteMatch{{Path: &gatewayv1alpha2.HTTPPathMatch{Value: &path}}}
+	}
+	tests := []struct {
+		name                  string
+		rules                 []gatewayv1alpha2.HTTPRouteRule
+		expectedService       string
+		expectedUnexposedRefs []string
+	}{
+		{
+			name:                  "weighted canary",
+			rules:                 []gatewayv1alpha2.HTTPRouteRule{{BackendRefs: []gatewayv1alpha2.HTTPBackendRef{weighted("shop-canary", 10), weighted("shop", 90)}}},
+			expectedService:       "shop",
+			expectedUnexposedRefs: []string{"shop-canary:80"},
+		},
+		{
+			name: "rules of different paths",
+			rules: []gatewayv1alpha2.HTTPRouteRule{
+				{Matches: pathMatch("/api"), BackendRefs: []gatewayv1alpha2.HTTPBackendRef{httpBackendRef("api", 80)}},
+				{Matches: pathMatch("/"), BackendRefs: []gatewayv1alpha2.HTTPBackendRef{httpBackendRef("shop", 80)}},
+				{Matches: pathMatch("/static"), BackendRefs: []gatewayv1alpha2.HTTPBackendRef{httpBackendRef("shop", 80)}},
+			},
+			expectedService:       "shop",
+			expectedUnexposedRefs: []string{"api:80"},
+		},
+		{
+			name: "no rule of the root path",
+			rules: []gatewayv1alpha2.HTTPRouteRule{
+				{Matches: pathMatch("/api"), BackendRefs: []gatewayv1alpha2.HTTPBackendRef{httpBackendRef("api", 80)}},
+				{Matches: pathMatch("/admin"), BackendRefs: []gatewayv1alpha2.HTTPBackendRef{weighted("admin", 5)}},
+			},
+			expectedService:       "api",
+			expectedUnexposedRefs: []string{"admin:80"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			gateway := &gatewayv1alpha2.Gateway{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{SiteAnnotation: "my-site"}}}
+			route := &gatewayv1alpha2.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: "apps"},
+				Spec: gatewayv1alpha2.HTTPRouteSpec{
+					Hostnames: []gatewayv1alpha2.Hostname{"shop.a.com", "shop.b.com"},
+					Rules:     tt.rules,
+				},
+			}
+
+			// when
+			got, _, unexposedRefs, err := NewHTTPRouteConverter().ConvertToHttpApplications(route, gateway)
+
+			// then one application is generated per hostname
+			require.NoError(t, err)
+			require.Len(t, got, 2)
+			subDomains := map[string]bool{}
+			for _, application := range got {
+				assert.Equal(t, tt.expectedService, application.Spec.Service.Name)
+				subDomains[application.Spec.HttpConnectionSettings.SubDomain] = true
+			}
+			assert.Equal(t, map[string]bool{"shop-a-com": true, "shop-b-com": true}, subDomains)
+			assert.Equal(t, tt.expectedUnexposedRefs, unexposedRefs)
+		})
+	}
+}
+
+func TestHTTPRouteConverter_ConvertToRouteConditions(t *testing.T) {
+	synced := func(status metav1.ConditionStatus, reason, message string) accessv1.HttpApplication {
+		return accessv1.HttpApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: "shop-1234"},
+			Status: accessv1.CommonApplicationStatus{Conditions: []metav1.Condition{
+				{Type: accessv1.ConditionSynced, Status: status, Reason: reason, Message: message},
+				{Type: accessv1.ConditionServiceAvailable, Status: metav1.ConditionTrue, Reason: accessv1.ReasonServiceAvailable},
+			}},
+		}
+	}
+	serviceNotFound := synced(metav1.ConditionTrue, accessv1.ReasonReconciled, "")
+	meta.SetStatusCondition(&serviceNotFound.Status.Conditions, metav1.Condition{Type: accessv1.ConditionServiceAvailable, Status: metav1.ConditionFalse, Reason: accessv1.ReasonServiceNotFound, Message: "service apps/shop was not found"})
+
+	tests := []struct {
+		name                 string
+		applications         []accessv1.HttpApplication
+		unresolvedRefs       []string
+		reconcileError       error
+		expectedAccepted     string
+		expectedResolvedRefs string
+	}{
+		{
+			name:                 "reconciled",
+			applications:         []accessv1.HttpApplication{synced(metav1.ConditionTrue, accessv1.ReasonReconciled, "")},
+			expectedAccepted:     RouteReasonAccepted,
+			expectedResolvedRefs: RouteReasonResolvedRefs,
+		},
+		{
+			name:                 "not reconciled yet",
+			applications:         []accessv1.HttpApplication{{ObjectMeta: metav1.ObjectMeta{Name: "shop-1234"}}},
+			expectedAccepted:     RouteReasonPending,
+			expectedResolvedRefs: RouteReasonResolvedRefs,
+		},
+		{
+			name:                 "reconcile failed",
+			applications:         []accessv1.HttpApplication{synced(metav1.ConditionFalse, accessv1.ReasonUnrecoverableError, "site my-site does not exist")},
+			expectedAccepted:     accessv1.ReasonUnrecoverableError,
+			expectedResolvedRefs: RouteReasonResolvedRefs,
+		},
+		{
+			name:                 "service not found",
+			applications:         []accessv1.HttpApplication{serviceNotFound},
+			expectedAccepted:     RouteReasonAccepted,
+			expectedResolvedRefs: accessv1.ReasonServiceNotFound,
+		},
+		{
+			name:                 "unresolved refs",
+			applications:         []accessv1.HttpApplication{synced(metav1.ConditionTrue, accessv1.ReasonReconciled, "")},
+			unresolvedRefs:       []string{"backendRef shop has no port"},
+			expectedAccepted:     RouteReasonAccepted,
+			expectedResolvedRefs: RouteReasonInvalidBackend,
+		},
+		{
+			name:                 "invalid route",
+			reconcileError:       errors.New("apps/shop is missing the site annotation"),
+			expectedAccepted:     RouteReasonInvalidRoute,
+			expectedResolvedRefs: RouteReasonResolvedRefs,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions := NewHTTPRouteConverter().ConvertToRouteConditions(nil, 2, tt.applications, tt.unresolvedRefs, tt.reconcileError)
+
+			accepted := meta.FindStatusCondition(conditions, string(gatewayv1alpha2.ConditionRouteAccepted))
+			require.NotNil(t, accepted)
+			assert.Equal(t, tt.expectedAccepted, accepted.Reason)
+			assert.Equal(t, tt.expectedAccepted == RouteReasonAccepted, accepted.Status == metav1.ConditionTrue)
+			assert.Equal(t, int64(2), accepted.ObservedGeneration)
+
+			resolvedRefs := meta.FindStatusCondition(conditions, string(gatewayv1alpha2.ConditionRouteResolvedRefs))
+			require.NotNil(t, resolvedRefs)
+			assert.Equal(t, tt.expectedResolvedRefs, resolvedRefs.Reason)
+			assert.Equal(t, tt.expectedResolvedRefs == RouteReasonResolvedRefs, resolvedRefs.Status == metav1.ConditionTrue)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package converter
+
+import (
+	"fmt"
+	"strconv"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+)
+
+const (
+	// IngressNameLabel is set on the generated HttpApplications with the name of their Ingress
+	IngressNameLabel = "access.secure-access-cloud.symantec.com/ingress"
+
+	legacyIngressClassAnnotation = "kubernetes.io/ingress.class"
+)
+
+// IngressConverter converts an Ingress to the HttpApplications exposing its backends in Secure-Access-Cloud
+type IngressConverter struct {
+	// IngressClassName is the IngressClass of the Ingresses which are exposed by the operator
+	IngressClassName string
+}
+
+func NewIngressConverter(ingressClassName string) *IngressConverter {
+	return &IngressConverter{IngressClassName: ingressClassName}
+}
+
+// IsExposed returns whether the Ingress is of the operator IngressClass or is annotated to be exposed.
+func (c *IngressConverter) IsExposed(ingress *networkingv1.Ingress) bool {
+	if ingress.Spec.IngressClassName != nil {
+		if *ingress.Spec.IngressClassName == c.IngressClassName {
+			return true
+		}
+	} else if ingress.Annotations[legacyIngressClassAnnotation] == c.IngressClassName {
+		return true
+	}
+	return isExposedByAnnotation(ingress)
+}
+
+// ConvertToHttpApplications converts the Ingress to one HttpApplication per host, a host listed in the Ingress TLS is
+// exposed on a custom domain with the TLS secret as its certificate. An application exposes a single service on the
+// domain of its host: the backend of the root path of the host (or the default backend for the rules without host),
+// else of its first path. The other backends of the host are returned as not exposed.
+func (c *IngressConverter) ConvertToHttpApplications(ingress *networkingv1.Ingress) (applications []accessv1.HttpApplication, unexposedBackends []string, err error) {
+
+	commonParams, err := convertExposeAnnotations(ingress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tlsSecrets := map[string]string{}
+	for _, tls := range ingress.Spec.TLS {
+		for _, host := range tls.Hosts {
+			tlsSecrets[host] = tls.SecretName
+		}
+	}
+
+	var hosts []string
+	hostBackends := map[string][]ingressPathBackend{}
+	addBackend := func(host, path string, backend *networkingv1.IngressBackend) {
+		if backend == nil || backend.Service == nil {
+			// resource backends cannot be exposed
+			return
+		}
+		service := accessv1.Service{Name: backend.Service.Name, Port: backend.Service.Port.Name}
+		if service.Port == "" {
+			service.Port = strconv.Itoa(int(backend.Service.Port.Number))
+		}
+		if _, ok := hostBackends[host]; !ok {
+			hosts = append(hosts, host)
+		}
+		hostBackends[host] = append(hostBackends[host], ingressPathBackend{path: path, service: service})
+	}
+
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for i := range rule.HTTP.Paths {
+			addBackend(rule.Host, rule.HTTP.Paths[i].Path, &rule.HTTP.Paths[i].Backend)
+		}
+	}
+	// the default backend serves the paths of the rules without host which are not matched
+	addBackend("", "/", ingress.Spec.DefaultBackend)
+
+	generated := map[string]accessv1.HttpApplication{}
+	for _, host := range hosts {
+		exposed := rootPathBackend(hostBackends[host])
+		for _, backend := range hostBackends[host] {
+			if backend.service != exposed.service {
+				unexposedBackends = append(unexposedBackends, fmt.Sprintf("%s%s (service %s:%s)", host, backend.path, backend.service.Name, backend.service.Port))
+			}
+		}
+
+		name := generatedApplicationName(ingress.Name, host, exposed.service)
+		subType, connectionSettings := convertHostToConnectionSettings(host, tlsSecrets[host])
+		generated[name] = accessv1.HttpApplication{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: ingress.Namespace,
+				Labels:    map[string]string{IngressNameLabel: ingress.Name},
+			},
+			Spec: accessv1.HttpApplicationSpec{
+				CommonApplicationParams: commonParams,
+				SubType:                 subType,
+				Service:                 exposed.service,
+				HttpConnectionSettings:  connectionSettings,
+			},
+		}
+	}
+
+	return sortedApplications(generated), unexposedBackends, nil
+}
+
+// ingressPathBackend is the service of a path of an Ingress host.
+type ingressPathBackend struct {
+	path    string
+	service accessv1.Service
+}
+
+// rootPathBackend returns the backend of the root path of a host, or its first backend when the root path is not
+// routed.
+func rootPathBackend(backends []ingressPathBackend) ingressPathBackend {
+	for _, backend := range backends {
+		if backend.path == "/" || backend.path == "" {
+			return backend
+		}
+	}
+	return backends[0]
+}
@@ -0,0 +1,220 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+)
+
+func ingressBackend(service string, port networkingv1.ServiceBackendPort) networkingv1.IngressBackend {
+	return networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: service, Port: port}}
+}
+
+func TestIngressConverter_IsExposed(t *testing.T) {
+	className := "secure-access-cloud"
+	otherClassName := "nginx"
+	tests := []struct {
+		name        string
+		className   *string
+		annotations map[string]string
+		expected    bool
+	}{
+		{name: "operator ingress class", className: &className, expected: true},
+		{name: "other ingress class", className: &otherClassName, expected: false},
+		{name: "legacy ingress class annotation", annotations: map[string]string{"kubernetes.io/ingress.class": className}, expected: true},
+		{name: "expose annotation", className: &otherClassName, annotations: map[string]string{ExposeAnnotation: "true"}, expected: true},
+		{name: "no ingress class", expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingress := &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations},
+				Spec:       networkingv1.IngressSpec{IngressClassName: tt.className},
+			}
+			assert.Equal(t, tt.expected, NewIngressConverter(className).IsExposed(ingress))
+		})
+	}
+}
+
+func TestIngressConverter_ConvertToHttpApplications(t *testing.T) {
+	// given
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web",
+			Namespace: "apps",
+			Annotations: map[string]string{
+				SiteAnnotation:           "my-site",
+				AccessPoliciesAnnotation: "developers, admins",
+			},
+		},
+		Spec: networkingv1.IngressSpec{
+			TLS: []networkingv1.IngressTLS{
+				{Hosts: []string{"shop.example.com"}, SecretName: "shop-tls"},
+				{Hosts: []string{"*.example.com"}, SecretName: "wildcard-tls"},
+			},
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: "dashboard.internal.example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{
+							{Path: "/", Backend: ingressBackend("dashboard", networkingv1.ServiceBackendPort{Name: "http"})},
+							{Path: "/api", Backend: ingressBackend("dashboard", networkingv1.ServiceBackendPort{Name: "http"})},
+						},
+					}},
+				},
+				{
+					Host: "shop.example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{
+							{Path: "/", Backend: ingressBackend("shop", networkingv1.ServiceBackendPort{Number: 8080})},
+						},
+					}},
+				},
+				{
+					Host: "*.example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{
+							{Path: "/", Backend: ingressBackend("tenants", networkingv1.ServiceBackendPort{Number: 80})},
+						},
+					}},
+				},
+			},
+		},
+	}
+	commonParams := accessv1.CommonApplicationParams{SiteName: "my-site", AccessPoliciesNames: []string{"developers", "admins"}}
+
+	// when
+	got, unexposedBackends, err := NewIngressConverter("secure-access-cloud").ConvertToHttpApplications(ingress)
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, unexposedBackends)
+	require.Len(t, got, 3)
+	specs := map[string]accessv1.HttpApplicationSpec{}
+	for _, application := range got {
+		assert.Equal(t, "apps", application.Namespace)
+		assert.Equal(t, map[string]string{IngressNameLabel: "web"}, application.Labels)
+		assert.Regexp(t, "^web-[0-9a-f]{8}$", application.Name)
+		specs[application.Spec.Service.Name] = application.Spec
+	}
+	assert.Equal(t, accessv1.HttpApplicationSpec{
+		CommonApplicationParams: commonParams,
+		SubType:                 model.AutoGeneratedDomain,
+		Service:                 accessv1.Service{Name: "dashboard", Port: "http"},
+		HttpConnectionSettings:  &accessv1.HttpConnectionSettings{SubDomain: "dashboard-internal-example-com"},
+	}, specs["dashboard"])
+	assert.Equal(t, accessv1.HttpApplicationSpec{
+		CommonApplicationParams: commonParams,
+		SubType:                 model.CustomDomain,
+		Service:                 accessv1.Service{Name: "shop", Port: "8080"},
+		HttpConnectionSettings: &accessv1.HttpConnectionSettings{
+			CustomExternalAddress:          "shop.example.com",
+			CustomSSLCertificateSecretName: "shop-tls",
+		},
+	}, specs["shop"])
+	assert.Equal(t, accessv1.HttpApplicationSpec{
+		CommonApplicationParams: commonParams,
+		SubType:                 model.WildCardDomain,
+		Service:                 accessv1.Service{Name: "tenants", Port: "80"},
+		HttpConnectionSettings: &accessv1.HttpConnectionSettings{
+			CustomExternalAddress:          "*.example.com",
+			CustomSSLCertificateSecretName: "wildcard-tls",
+		},
+	}, specs["tenants"])
+}
+
+func TestIngressConverter_ConvertToHttpApplications_MissingSite(t *testing.T) {
+	ingress := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "apps"}}
+	_, _, err := NewIngressConverter("secure-access-cloud").ConvertToHttpApplications(ingress)
+	assert.Error(t, err)
+}
+
+func TestIngressConverter_ConvertToHttpApplications_MultipleBackends(t *testing.T) {
+	tests := []struct {
+		name                      string
+		spec                      networkingv1.IngressSpec
+		expectedServices          map[string]string
+		expectedUnexposedBackends []string
+	}{
+		{
+			name: "paths of a host routed to different services",
+			spec: networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{
+				Host: "app.a.com",
+				IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{
+						{Path: "/api", Backend: ingressBackend("svc-a", networkingv1.ServiceBackendPort{Number: 80})},
+						{Path: "/", Backend: ingressBackend("svc-b", networkingv1.ServiceBackendPort{Number: 80})},
+					},
+				}},
+			}}},
+			expectedServices:          map[string]string{"app-a-com": "svc-b"},
+			expectedUnexposedBackends: []string{"app.a.com/api (service svc-a:80)"},
+		},
+		{
+			name: "host without root path",
+			spec: networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{
+				Host: "app.a.com",
+				IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{
+						{Path: "/api", Backend: ingressBackend("svc-a", networkingv1.ServiceBackendPort{Number: 80})},
+						{Path: "/ui", Backend: ingressBackend("svc-b", networkingv1.ServiceBackendPort{Number: 80})},
+					},
+				}},
+			}}},
+			expectedServices:          map[string]string{"app-a-com": "svc-a"},
+			expectedUnexposedBackends: []string{"app.a.com/ui (service svc-b:80)"},
+		},
+		{
+			name: "default backend and rule without host",
+			spec: networkingv1.IngressSpec{
+				DefaultBackend: &networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "default", Port: networkingv1.ServiceBackendPort{Number: 80}}},
+				Rules: []networkingv1.IngressRule{
+					{
+						IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{Path: "/api", Backend: ingressBackend("api", networkingv1.ServiceBackendPort{Number: 80})},
+							},
+						}},
+					},
+					{
+						Host: "app.b.com",
+						IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{Path: "/", Backend: ingressBackend("web", networkingv1.ServiceBackendPort{Number: 80})},
+							},
+						}},
+					},
+				},
+			},
+			expectedServices:          map[string]string{"": "default", "app-b-com": "web"},
+			expectedUnexposedBackends: []string{"/api (service api:80)"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ingress := &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "apps", Annotations: map[string]string{SiteAnnotation: "my-site"}},
+				Spec:       tt.spec,
+			}
+
+			// when
+			got, unexposedBackends, err := NewIngressConverter("secure-access-cloud").ConvertToHttpApplications(ingress)
+
+			// then one application is generated per host
+			require.NoError(t, err)
+			services := map[string]string{}
+			for _, application := range got {
+				services[application.Spec.HttpConnectionSettings.SubDomain] = application.Spec.Service.Name
+			}
+			assert.Equal(t, tt.expectedServices, services)
+			assert.Equal(t, tt.expectedUnexposedBackends, unexposedBackends)
+		})
+	}
+}
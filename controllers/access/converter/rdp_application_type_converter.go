@@ -0,0 +1,100 @@
+package converter
+
+import (
+	"fmt"
+
+	"bitbucket.org/accezz-io/sac-operator/utils"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type RdpApplicationTypeConverter struct {
+	*CommonParamsConverter
+}
+
+func NewRdpApplicationTypeConverter() *RdpApplicationTypeConverter {
+	return &RdpApplicationTypeConverter{
+		&CommonParamsConverter{},
+	}
+}
+
+func (a *RdpApplicationTypeConverter) Validate(application *accessv1.RdpApplication) error {
+
+	switch utils.GetApplicationSubTypeOrDefault(application.Spec.SubType, model.RdpSingleMachine) {
+	case model.RdpSingleMachine:
+		if application.Spec.Service == nil {
+			return fmt.Errorf("service is required for a %s application", model.RdpSingleMachine)
+		}
+		if len(application.Spec.Services) != 0 {
+			return fmt.Errorf("services cannot be set for a %s application, use service instead", model.RdpSingleMachine)
+		}
+		return validateService(*application.Spec.Service)
+	case model.RdpMultipleMachines:
+		if len(application.Spec.Services) == 0 {
+			return fmt.Errorf("at least one service is required for a %s application", model.RdpMultipleMachines)
+		}
+		if application.Spec.Service != nil {
+			return fmt.Errorf("service cannot be set for a %s application, use services instead", model.RdpMultipleMachines)
+		}
+		for i := range application.Spec.Services {
+			if err := validateService(application.Spec.Services[i]); err != nil {
+				return fmt.Errorf("services[%d]: %w", i, err)
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported sub type %s", application.Spec.SubType)
+	}
+}
+
+// ExposedServices returns the services exposed by the application: its service for a single machine application, or
+// its services for a multiple machines application.
+func (a *RdpApplicationTypeConverter) ExposedServices(application *accessv1.RdpApplication) []accessv1.Service {
+	if application.Spec.Service != nil {
+		return []accessv1.Service{*application.Spec.Service}
+	}
+	return application.Spec.Services
+}
+
+// ConvertToModel converts the application to an RDP application model. servicePorts are the ports of the
+// ExposedServices resolved by the controller, in the same order, nil when they could not be resolved.
+func (a *RdpApplicationTypeConverter) ConvertToModel(application *accessv1.RdpApplication, servicePorts []*corev1.ServicePort) (*model.Application, error) {
+
+	output := &model.Application{
+		ID:                 application.Status.Id,
+		Type:               model.RDP,
+		SubType:            utils.GetApplicationSubTypeOrDefault(application.Spec.SubType, model.RdpSingleMachine),
+		ToDelete:           !application.ObjectMeta.DeletionTimestamp.IsZero(),
+		SpecChanged:        application.Generation != application.Status.ObservedGeneration,
+		ConnectionSettings: &model.ConnectionSettings{},
+	}
+
+	for i, service := range a.ExposedServices(application) {
+		var servicePort *corev1.ServicePort
+		if i < len(servicePorts) {
+			servicePort = servicePorts[i]
+		}
+		if application.Spec.Service != nil {
+			output.ConnectionSettings.InternalAddress = a.convertToInternalAddress(model.RDP, service, servicePort, application.Namespace)
+			continue
+		}
+		output.TcpTunnelSettings = append(output.TcpTunnelSettings, model.TcpTunnelSetting{
+			Target: a.convertToServiceHost(service, application.Namespace),
+			Ports:  []string{convertToPort(service, servicePort)},
+		})
+	}
+
+	commonParams := model.CommonApplicationParams{
+		Name: application.Name,
+	}
+	err := a.copyCommonParams(application.Spec.CommonApplicationParams, &commonParams)
+	if err != nil {
+		return output, err
+	}
+
+	output.CommonApplicationParams = commonParams
+
+	return output, nil
+}
@@ -0,0 +1,199 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+)
+
+func TestRdpApplicationTypeConverter_Validate(t *testing.T) {
+	service := accessv1.Service{Name: "windows-vm", Port: "3389"}
+	tests := []struct {
+		name    string
+		spec    accessv1.RdpApplicationSpec
+		wantErr bool
+	}{
+		{
+			name: "single machine with service",
+			spec: accessv1.RdpApplicationSpec{SubType: model.RdpSingleMachine, Service: &service},
+		},
+		{
+			name: "default sub type with service",
+			spec: accessv1.RdpApplicationSpec{Service: &service},
+		},
+		{
+			name:    "single machine without service",
+			spec:    accessv1.RdpApplicationSpec{SubType: model.RdpSingleMachine},
+			wantErr: true,
+		},
+		{
+			name:    "single machine with services",
+			spec:    accessv1.RdpApplicationSpec{SubType: model.RdpSingleMachine, Service: &service, Services: []accessv1.Service{service}},
+			wantErr: true,
+		},
+		{
+			name: "multiple machines with services",
+			spec: accessv1.RdpApplicationSpec{SubType: model.RdpMultipleMachines, Services: []accessv1.Service{service, service}},
+		},
+		{
+			name:    "multiple machines without services",
+			spec:    accessv1.RdpApplicationSpec{SubType: model.RdpMultipleMachines},
+			wantErr: true,
+		},
+		{
+			name:    "multiple machines with service",
+			spec:    accessv1.RdpApplicationSpec{SubType: model.RdpMultipleMachines, Service: &service, Services: []accessv1.Service{service}},
+			wantErr: true,
+		},
+		{
+			name:    "multiple machines with a service without port",
+			spec:    accessv1.RdpApplicationSpec{SubType: model.RdpMultipleMachines, Services: []accessv1.Service{service, {Name: "windows-vm-2"}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewRdpApplicationTypeConverter()
+			err := a.Validate(&accessv1.RdpApplication{Spec: tt.spec})
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRdpApplicationTypeConverter_ConvertToModel(t *testing.T) {
+	tests := []struct {
+		name         string
+		application  *accessv1.RdpApplication
+		servicePorts []*corev1.ServicePort
+		expected     *model.Application
+	}{
+		{
+			name: "single machine flow",
+			application: &accessv1.RdpApplication{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-windows-vm", Namespace: "vms"},
+				Spec: accessv1.RdpApplicationSpec{
+					CommonApplicationParams: accessv1.CommonApplicationParams{SiteName: "my-site"},
+					Service:                 &accessv1.Service{Name: "windows-vm", Port: "3389"},
+				},
+				Status: accessv1.CommonApplicationStatus{Id: "uuid"},
+			},
+			expected: &model.Application{
+				ID:      "uuid",
+				Type:    model.RDP,
+				SubType: model.RdpSingleMachine,
+				CommonApplicationParams: model.CommonApplicationParams{
+					Name:      "my-windows-vm",
+					SiteName:  "my-site",
+					IsVisible: true,
+					Enabled:   true,
+				},
+				ConnectionSettings: &model.ConnectionSettings{
+					InternalAddress: "tcp://windows-vm.vms:3389",
+				},
+			},
+		},
+		{
+			name: "multiple machines flow",
+			application: &accessv1.RdpApplication{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-windows-vms", Namespace: "vms"},
+				Spec: accessv1.RdpApplicationSpec{
+					CommonApplicationParams: accessv1.CommonApplicationParams{SiteName: "my-site"},
+					SubType:                 model.RdpMultipleMachines,
+					Services: []accessv1.Service{
+						{Name: "windows-vm-1", Port: "3389"},
+						{Name: "windows-vm-2", Namespace: "other-vms", Port: "3390"},
+					},
+				},
+			},
+			expected: &model.Application{
+				Type:    model.RDP,
+				SubType: model.RdpMultipleMachines,
+				CommonApplicationParams: model.CommonApplicationParams{
+					Name:      "my-windows-vms",
+					SiteName:  "my-site",
+					IsVisible: true,
+					Enabled:   true,
+				},
+				ConnectionSettings: &model.ConnectionSettings{},
+				TcpTunnelSettings: []model.TcpTunnelSetting{
+					{Target: "windows-vm-1.vms", Ports: []string{"3389"}},
+					{Target: "windows-vm-2.other-vms", Ports: []string{"3390"}},
+				},
+			},
+		},
+		{
+			name: "single machine named port",
+			application: &accessv1.RdpApplication{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-windows-vm", Namespace: "vms"},
+				Spec: accessv1.RdpApplicationSpec{
+					CommonApplicationParams: accessv1.CommonApplicationParams{SiteName: "my-site"},
+					Service:                 &accessv1.Service{Name: "windows-vm", Port: "rdp"},
+				},
+			},
+			servicePorts: []*corev1.ServicePort{{Name: "rdp", Port: 3389}},
+			expected: &model.Application{
+				Type:    model.RDP,
+				SubType: model.RdpSingleMachine,
+				CommonApplicationParams: model.CommonApplicationParams{
+					Name:      "my-windows-vm",
+					SiteName:  "my-site",
+					IsVisible: true,
+					Enabled:   true,
+				},
+				ConnectionSettings: &model.ConnectionSettings{
+					InternalAddress: "tcp://windows-vm.vms:3389",
+				},
+			},
+		},
+		{
+			name: "multiple machines named ports",
+			application: &accessv1.RdpApplication{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-windows-vms", Namespace: "vms"},
+				Spec: accessv1.RdpApplicationSpec{
+					CommonApplicationParams: accessv1.CommonApplicationParams{SiteName: "my-site"},
+					SubType:                 model.RdpMultipleMachines,
+					Services: []accessv1.Service{
+						{Name: "windows-vm-1", Port: "rdp"},
+						{Name: "windows-vm-2", Port: "rdp"},
+					},
+				},
+			},
+			// the port of the second service was not resolved
+			servicePorts: []*corev1.ServicePort{{Name: "rdp", Port: 3389}, nil},
+			expected: &model.Application{
+				Type:    model.RDP,
+				SubType: model.RdpMultipleMachines,
+				CommonApplicationParams: model.CommonApplicationParams{
+					Name:      "my-windows-vms",
+					SiteName:  "my-site",
+					IsVisible: true,
+					Enabled:   true,
+				},
+				ConnectionSettings: &model.ConnectionSettings{},
+				TcpTunnelSettings: []model.TcpTunnelSetting{
+					{Target: "windows-vm-1.vms", Ports: []string{"3389"}},
+					{Target: "windows-vm-2.vms", Ports: []string{"rdp"}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &RdpApplicationTypeConverter{}
+			got, err := a.ConvertToModel(tt.application, tt.servicePorts)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package converter
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
+)
+
+const (
+	TenantDomainSecretKey = "tenantDomain"
+	ClientIDSecretKey     = "clientId"
+	ClientSecretSecretKey = "clientSecret"
+)
+
+// SecureAccessCloudTenantConverter converts the tenant credentials secret to the SAC client settings
+type SecureAccessCloudTenantConverter struct{}
+
+func NewSecureAccessCloudTenantConverter() *SecureAccessCloudTenantConverter {
+	return &SecureAccessCloudTenantConverter{}
+}
+
+func (c *SecureAccessCloudTenantConverter) ConvertToSettings(secret *corev1.Secret) (*sac.SecureAccessCloudSettings, error) {
+
+	for _, key := range []string{TenantDomainSecretKey, ClientIDSecretKey, ClientSecretSecretKey} {
+		if len(secret.Data[key]) == 0 {
+			return nil, fmt.Errorf("secret %s/%s is missing the %s key", secret.Namespace, secret.Name, key)
+		}
+	}
+
+	settings := &sac.SecureAccessCloudSettings{
+		TenantDomain: string(secret.Data[TenantDomainSecretKey]),
+		ClientID:     string(secret.Data[ClientIDSecretKey]),
+		ClientSecret: string(secret.Data[ClientSecretSecretKey]),
+	}
+
+	return settings, nil
+}
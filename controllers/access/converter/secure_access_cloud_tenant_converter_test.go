@@ -0,0 +1,56 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
+)
+
+func TestSecureAccessCloudTenantConverter_ConvertToSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     map[string][]byte
+		expected *sac.SecureAccessCloudSettings
+		wantErr  bool
+	}{
+		{
+			name: "happy flow",
+			data: map[string][]byte{
+				"tenantDomain": []byte("staging.example.com"),
+				"clientId":     []byte("id"),
+				"clientSecret": []byte("secret"),
+			},
+			expected: &sac.SecureAccessCloudSettings{TenantDomain: "staging.example.com", ClientID: "id", ClientSecret: "secret"},
+		},
+		{
+			name: "missing client secret",
+			data: map[string][]byte{
+				"tenantDomain": []byte("staging.example.com"),
+				"clientId":     []byte("id"),
+			},
+			wantErr: true,
+		},
+		{
+			name:    "empty secret",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewSecureAccessCloudTenantConverter()
+			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: "secure-access-cloud-system"}, Data: tt.data}
+			got, err := c.ConvertToSettings(secret)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
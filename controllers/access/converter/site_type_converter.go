@@ -22,23 +22,34 @@ func (s *SiteConverter) ConvertToServiceModel(site *accessv1.Site) *model.Site {
 
 	siteModel := &model.Site{
 		Name:                   site.Name,
+		TenantName:             site.Spec.TenantRef,
 		SiteNamespace:          site.Namespace,
 		SACSiteID:              site.Status.ID,
 		NumberOfConnectors:     site.Spec.NumberOfConnectors,
 		ToDelete:               !site.ObjectMeta.DeletionTimestamp.IsZero(),
+		DriftPolicy:            site.Spec.DriftPolicy,
+		SpecChanged:            site.Generation != site.Status.ObservedGeneration,
 		ConnectorConfiguration: connectorConfiguration,
 	}
 
 	return siteModel
 }
 
-func (s *SiteConverter) ConvertFromServiceOutput(site *service.SiteReconcileOutput) accessv1.SiteStatus {
+// ConvertFromServiceOutput converts the reconcile output of the given site, and the reconcile error, to the site
+// status. The conditions of the current site status are carried over in order to keep their transition times, and the
+// site generation is observed only once reconciled successfully.
+func (s *SiteConverter) ConvertFromServiceOutput(siteCRD *accessv1.Site, site *service.SiteReconcileOutput, reconcileError error) accessv1.SiteStatus {
 
 	siteStatus := accessv1.SiteStatus{
 		ID:                        "",
 		HealthyConnectors:         map[string]string{},
 		UnHealthyConnectors:       map[string]string{},
 		NumberOfHealthyConnectors: 0,
+		ObservedGeneration:        siteCRD.Status.ObservedGeneration,
+		Conditions:                siteCRD.Status.Conditions,
+	}
+	if reconcileError == nil {
+		siteStatus.ObservedGeneration = siteCRD.Generation
 	}
 
 	siteStatus.ID = site.SACSiteID
@@ -49,6 +60,9 @@ func (s *SiteConverter) ConvertFromServiceOutput(site *service.SiteReconcileOutp
 		siteStatus.UnHealthyConnectors[site.UnHealthyConnectors[i].DeploymentName] = site.UnHealthyConnectors[i].SacID
 	}
 	siteStatus.NumberOfHealthyConnectors = len(site.HealthyConnectors)
+	siteStatus.Drift = site.Drift
+
+	setSiteConditions(&siteStatus, siteCRD.Generation, siteCRD.Spec.NumberOfConnectors, reconcileError)
 
 	return siteStatus
 }
@@ -1,19 +1,23 @@
 package converter
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	"bitbucket.org/accezz-io/sac-operator/service"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
 )
 
 func TestSiteConverter_ConvertFromServiceModel(t *testing.T) {
 	type args struct {
-		site *service.SiteReconcileOutput
+		siteCRD        *accessv1.Site
+		site           *service.SiteReconcileOutput
+		reconcileError error
 	}
 	tests := []struct {
 		name string
@@ -23,6 +27,10 @@ func TestSiteConverter_ConvertFromServiceModel(t *testing.T) {
 		{
 			name: "happy-flow",
 			args: args{
+				siteCRD: &accessv1.Site{
+					ObjectMeta: metav1.ObjectMeta{Generation: 2},
+					Spec:       accessv1.SiteSpec{NumberOfConnectors: 1},
+				},
 				site: &service.SiteReconcileOutput{
 					Deleted:   false,
 					SACSiteID: "51f33785-434d-41cf-8eae-7c07f43afbe1",
@@ -47,13 +55,46 @@ func TestSiteConverter_ConvertFromServiceModel(t *testing.T) {
 					"dep2": "uuid2",
 				},
 				NumberOfHealthyConnectors: 1,
+				ObservedGeneration:        2,
+				Conditions: []metav1.Condition{
+					{Type: accessv1.ConditionSynced, Status: metav1.ConditionTrue, ObservedGeneration: 2, Reason: accessv1.ReasonReconciled},
+					{Type: accessv1.ConditionConnectorsAvailable, Status: metav1.ConditionTrue, ObservedGeneration: 2, Reason: accessv1.ReasonConnectorsAvailable, Message: "1/1 connectors are healthy"},
+					{Type: accessv1.ConditionReady, Status: metav1.ConditionTrue, ObservedGeneration: 2, Reason: accessv1.ReasonReconciled},
+					{Type: accessv1.ConditionDegraded, Status: metav1.ConditionFalse, ObservedGeneration: 2, Reason: accessv1.ReasonReconciled},
+				},
+			},
+		},
+		{
+			name: "missing connectors and reconcile error",
+			args: args{
+				siteCRD: &accessv1.Site{
+					ObjectMeta: metav1.ObjectMeta{Generation: 2},
+					Spec:       accessv1.SiteSpec{NumberOfConnectors: 2},
+					Status:     accessv1.SiteStatus{ObservedGeneration: 1},
+				},
+				site:           &service.SiteReconcileOutput{SACSiteID: "51f33785-434d-41cf-8eae-7c07f43afbe1"},
+				reconcileError: errors.New("failed to create connector"),
+			},
+			want: accessv1.SiteStatus{
+				ID:                  "51f33785-434d-41cf-8eae-7c07f43afbe1",
+				HealthyConnectors:   map[string]string{},
+				UnHealthyConnectors: map[string]string{},
+				// the failed generation is not observed
+				ObservedGeneration: 1,
+				Conditions: []metav1.Condition{
+					{Type: accessv1.ConditionSynced, Status: metav1.ConditionFalse, ObservedGeneration: 2, Reason: accessv1.ReasonReconcileFailed, Message: "failed to create connector"},
+					{Type: accessv1.ConditionConnectorsAvailable, Status: metav1.ConditionFalse, ObservedGeneration: 2, Reason: accessv1.ReasonConnectorsUnavailable, Message: "0/2 connectors are healthy"},
+					{Type: accessv1.ConditionReady, Status: metav1.ConditionFalse, ObservedGeneration: 2, Reason: accessv1.ReasonReconcileFailed, Message: "failed to create connector"},
+					{Type: accessv1.ConditionDegraded, Status: metav1.ConditionTrue, ObservedGeneration: 2, Reason: accessv1.ReasonReconcileFailed, Message: "failed to create connector"},
+				},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			s := &SiteConverter{}
-			site := s.ConvertFromServiceOutput(tt.args.site)
+			site := s.ConvertFromServiceOutput(tt.args.siteCRD, tt.args.site, tt.args.reconcileError)
+			clearTransitionTimes(site.Conditions)
 			assert.Equal(t, tt.want, site)
 		})
 	}
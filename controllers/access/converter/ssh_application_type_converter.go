@@ -0,0 +1,60 @@
+package converter
+
+import (
+	"bitbucket.org/accezz-io/sac-operator/utils"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type SshApplicationTypeConverter struct {
+	*CommonParamsConverter
+}
+
+func NewSshApplicationTypeConverter() *SshApplicationTypeConverter {
+	return &SshApplicationTypeConverter{
+		&CommonParamsConverter{},
+	}
+}
+
+func (a *SshApplicationTypeConverter) Validate(application *accessv1.SshApplication) error {
+
+	return validateService(application.Spec.Service)
+}
+
+// ConvertToModel converts the application to an SSH application model. servicePort is the port of the exposed Service
+// resolved by the controller, nil when it could not be resolved.
+func (a *SshApplicationTypeConverter) ConvertToModel(application *accessv1.SshApplication, servicePort *corev1.ServicePort) (*model.Application, error) {
+
+	output := &model.Application{
+		ID:          application.Status.Id,
+		Type:        model.SSH,
+		ToDelete:    !application.ObjectMeta.DeletionTimestamp.IsZero(),
+		SpecChanged: application.Generation != application.Status.ObservedGeneration,
+		ConnectionSettings: &model.ConnectionSettings{
+			InternalAddress: a.convertToInternalAddress(model.SSH, application.Spec.Service, servicePort, application.Namespace),
+		},
+	}
+
+	if application.Spec.SshSettings != nil {
+		output.SshSettings = &model.SshSettings{
+			UserAccounts:        application.Spec.SshSettings.UserAccounts,
+			FullUPNAutoGenerate: utils.Convert_Pointer_bool_To_bool_with_default(application.Spec.SshSettings.FullUPNAutoGenerate, false),
+			UPNAutoGenerate:     utils.Convert_Pointer_bool_To_bool_with_default(application.Spec.SshSettings.UPNAutoGenerate, false),
+			EmailAutoGenerate:   utils.Convert_Pointer_bool_To_bool_with_default(application.Spec.SshSettings.EmailAutoGenerate, false),
+		}
+	}
+
+	commonParams := model.CommonApplicationParams{
+		Name: application.Name,
+	}
+	err := a.copyCommonParams(application.Spec.CommonApplicationParams, &commonParams)
+	if err != nil {
+		return output, err
+	}
+
+	output.CommonApplicationParams = commonParams
+
+	return output, nil
+}
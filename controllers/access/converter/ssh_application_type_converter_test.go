@@ -0,0 +1,157 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"bitbucket.org/accezz-io/sac-operator/utils"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+)
+
+func TestSshApplicationTypeConverter_ConvertToModel(t *testing.T) {
+	type args struct {
+		application *accessv1.SshApplication
+		servicePort *corev1.ServicePort
+	}
+	tests := []struct {
+		name        string
+		args        args
+		expected    *model.Application
+		errorOutput error
+	}{
+		{
+			name: "explicit flow",
+			args: args{
+				application: &accessv1.SshApplication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-bastion",
+						Namespace: "application-namespace",
+					},
+					Spec: accessv1.SshApplicationSpec{
+						CommonApplicationParams: accessv1.CommonApplicationParams{
+							SiteName:              "my-site",
+							AccessPoliciesNames:   []string{"access-policy"},
+							ActivityPoliciesNames: []string{"activity-policy"},
+							IsVisible:             utils.Convert_bool_To_Pointer_bool(false),
+							IsNotificationEnabled: utils.Convert_bool_To_Pointer_bool(true),
+							Enabled:               utils.Convert_bool_To_Pointer_bool(true),
+						},
+						Service: accessv1.Service{
+							Name: "bastion",
+							Port: "2222",
+						},
+						SshSettings: &accessv1.SshSettings{
+							UserAccounts:        []string{"ubuntu", "root"},
+							FullUPNAutoGenerate: utils.Convert_bool_To_Pointer_bool(true),
+						},
+					},
+					Status: accessv1.CommonApplicationStatus{
+						Id: "uuid",
+					},
+				},
+			},
+			expected: &model.Application{
+				ID:   "uuid",
+				Type: model.SSH,
+				CommonApplicationParams: model.CommonApplicationParams{
+					Name:                  "my-bastion",
+					SiteName:              "my-site",
+					IsVisible:             false,
+					IsNotificationEnabled: true,
+					Enabled:               true,
+					AccessPoliciesNames:   []string{"access-policy"},
+					ActivityPoliciesNames: []string{"activity-policy"},
+				},
+				ConnectionSettings: &model.ConnectionSettings{
+					InternalAddress: "tcp://bastion.application-namespace:2222",
+				},
+				SshSettings: &model.SshSettings{
+					UserAccounts:        []string{"ubuntu", "root"},
+					FullUPNAutoGenerate: true,
+					UPNAutoGenerate:     false,
+					EmailAutoGenerate:   false,
+				},
+			},
+		},
+		{
+			name: "default flow",
+			args: args{
+				application: &accessv1.SshApplication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-bastion",
+					},
+					Spec: accessv1.SshApplicationSpec{
+						CommonApplicationParams: accessv1.CommonApplicationParams{
+							SiteName: "my-site",
+						},
+						Service: accessv1.Service{
+							Name:      "bastion",
+							Namespace: "service-namespace",
+							Port:      "22",
+						},
+					},
+				},
+			},
+			expected: &model.Application{
+				Type: model.SSH,
+				CommonApplicationParams: model.CommonApplicationParams{
+					Name:      "my-bastion",
+					SiteName:  "my-site",
+					IsVisible: true,
+					Enabled:   true,
+				},
+				ConnectionSettings: &model.ConnectionSettings{
+					InternalAddress: "tcp://bastion.service-namespace:22",
+				},
+			},
+		},
+		{
+			name: "named port",
+			args: args{
+				application: &accessv1.SshApplication{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-bastion",
+						Namespace: "application-namespace",
+					},
+					Spec: accessv1.SshApplicationSpec{
+						CommonApplicationParams: accessv1.CommonApplicationParams{
+							SiteName: "my-site",
+						},
+						Service: accessv1.Service{
+							Name: "bastion",
+							Port: "ssh",
+						},
+					},
+				},
+				servicePort: &corev1.ServicePort{Name: "ssh", Port: 2222},
+			},
+			expected: &model.Application{
+				Type: model.SSH,
+				CommonApplicationParams: model.CommonApplicationParams{
+					Name:      "my-bastion",
+					SiteName:  "my-site",
+					IsVisible: true,
+					Enabled:   true,
+				},
+				ConnectionSettings: &model.ConnectionSettings{
+					InternalAddress: "tcp://bastion.application-namespace:2222",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &SshApplicationTypeConverter{}
+			got, err := a.ConvertToModel(tt.args.application, tt.args.servicePort)
+			require.Equal(t, tt.errorOutput, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package converter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+)
+
+type TcpApplicationTypeConverter struct {
+	*CommonParamsConverter
+}
+
+func NewTcpApplicationTypeConverter() *TcpApplicationTypeConverter {
+	return &TcpApplicationTypeConverter{
+		&CommonParamsConverter{},
+	}
+}
+
+func (a *TcpApplicationTypeConverter) Validate(application *accessv1.TcpApplication) error {
+
+	if len(application.Spec.Targets) == 0 {
+		return fmt.Errorf("at least one target is required")
+	}
+
+	for i, target := range application.Spec.Targets {
+		if err := validateTcpTarget(target); err != nil {
+			return fmt.Errorf("targets[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (a *TcpApplicationTypeConverter) ConvertToModel(application *accessv1.TcpApplication) (*model.Application, error) {
+
+	output := &model.Application{
+		ID:                 application.Status.Id,
+		Type:               model.TCP,
+		ToDelete:           !application.ObjectMeta.DeletionTimestamp.IsZero(),
+		SpecChanged:        application.Generation != application.Status.ObservedGeneration,
+		ConnectionSettings: &model.ConnectionSettings{},
+	}
+
+	for _, target := range application.Spec.Targets {
+		host := target.Host
+		if target.Service != nil {
+			host = a.convertToServiceHost(accessv1.Service{Name: target.Service.Name, Namespace: target.Service.Namespace}, application.Namespace)
+		}
+		output.TcpTunnelSettings = append(output.TcpTunnelSettings, model.TcpTunnelSetting{
+			Target: host,
+			Ports:  target.Ports,
+		})
+	}
+
+	commonParams := model.CommonApplicationParams{
+		Name: application.Name,
+	}
+	err := a.copyCommonParams(application.Spec.CommonApplicationParams, &commonParams)
+	if err != nil {
+		return output, err
+	}
+
+	output.CommonApplicationParams = commonParams
+
+	return output, nil
+}
+
+func validateTcpTarget(target accessv1.TcpTarget) error {
+
+	if target.Service == nil && target.Host == "" {
+		return fmt.Errorf("either service or host is required")
+	}
+	if target.Service != nil && target.Host != "" {
+		return fmt.Errorf("service and host cannot be set together")
+	}
+	if target.Service != nil && target.Service.Name == "" {
+		return fmt.Errorf("service name cannot be empty")
+	}
+	if len(target.Ports) == 0 {
+		return fmt.Errorf("at least one port is required")
+	}
+	for _, port := range target.Ports {
+		if err := validatePortRange(port); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validatePortRange accepts a single port ("5432") or an inclusive port range ("9092-9094").
+func validatePortRange(portRange string) error {
+
+	from, to := portRange, portRange
+	if i := strings.Index(portRange, "-"); i >= 0 {
+		from, to = portRange[:i], portRange[i+1:]
+	}
+
+	fromPort, err := parsePort(from)
+	if err != nil {
+		return fmt.Errorf("invalid port range %q: %w", portRange, err)
+	}
+	toPort, err := parsePort(to)
+	if err != nil {
+		return fmt.Errorf("invalid port range %q: %w", portRange, err)
+	}
+	if fromPort > toPort {
+		return fmt.Errorf("invalid port range %q: %d is greater than %d", portRange, fromPort, toPort)
+	}
+
+	return nil
+}
+
+func parsePort(port string) (int, error) {
+
+	value, err := strconv.Atoi(port)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", port)
+	}
+	if value < 1 || value > 65535 {
+		return 0, fmt.Errorf("%d is out of range", value)
+	}
+
+	return value, nil
+}
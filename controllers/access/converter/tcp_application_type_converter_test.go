@@ -0,0 +1,118 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+)
+
+func TestTcpApplicationTypeConverter_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		targets []accessv1.TcpTarget
+		wantErr bool
+	}{
+		{
+			name: "service and host targets",
+			targets: []accessv1.TcpTarget{
+				{Service: &accessv1.ServiceReference{Name: "postgres"}, Ports: []string{"5432"}},
+				{Host: "kafka.internal", Ports: []string{"9092-9094", "9200"}},
+			},
+		},
+		{
+			name:    "no targets",
+			wantErr: true,
+		},
+		{
+			name:    "neither service nor host",
+			targets: []accessv1.TcpTarget{{Ports: []string{"5432"}}},
+			wantErr: true,
+		},
+		{
+			name:    "both service and host",
+			targets: []accessv1.TcpTarget{{Service: &accessv1.ServiceReference{Name: "postgres"}, Host: "postgres.internal", Ports: []string{"5432"}}},
+			wantErr: true,
+		},
+		{
+			name:    "no ports",
+			targets: []accessv1.TcpTarget{{Host: "postgres.internal"}},
+			wantErr: true,
+		},
+		{
+			name:    "port is not a number",
+			targets: []accessv1.TcpTarget{{Host: "postgres.internal", Ports: []string{"postgres"}}},
+			wantErr: true,
+		},
+		{
+			name:    "port out of range",
+			targets: []accessv1.TcpTarget{{Host: "postgres.internal", Ports: []string{"70000"}}},
+			wantErr: true,
+		},
+		{
+			name:    "reversed port range",
+			targets: []accessv1.TcpTarget{{Host: "kafka.internal", Ports: []string{"9094-9092"}}},
+			wantErr: true,
+		},
+		{
+			name:    "open port range",
+			targets: []accessv1.TcpTarget{{Host: "kafka.internal", Ports: []string{"9092-"}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewTcpApplicationTypeConverter()
+			err := a.Validate(&accessv1.TcpApplication{Spec: accessv1.TcpApplicationSpec{Targets: tt.targets}})
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTcpApplicationTypeConverter_ConvertToModel(t *testing.T) {
+	// given
+	application := &accessv1.TcpApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-databases", Namespace: "databases"},
+		Spec: accessv1.TcpApplicationSpec{
+			CommonApplicationParams: accessv1.CommonApplicationParams{SiteName: "my-site"},
+			Targets: []accessv1.TcpTarget{
+				{Service: &accessv1.ServiceReference{Name: "postgres"}, Ports: []string{"5432"}},
+				{Service: &accessv1.ServiceReference{Name: "redis", Namespace: "cache"}, Ports: []string{"6379"}},
+				{Host: "kafka.internal", Ports: []string{"9092-9094"}},
+			},
+		},
+		Status: accessv1.CommonApplicationStatus{Id: "uuid"},
+	}
+
+	// when
+	a := NewTcpApplicationTypeConverter()
+	got, err := a.ConvertToModel(application)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, &model.Application{
+		ID:   "uuid",
+		Type: model.TCP,
+		CommonApplicationParams: model.CommonApplicationParams{
+			Name:      "my-databases",
+			SiteName:  "my-site",
+			IsVisible: true,
+			Enabled:   true,
+		},
+		ConnectionSettings: &model.ConnectionSettings{},
+		TcpTunnelSettings: []model.TcpTunnelSetting{
+			{Target: "postgres.databases", Ports: []string{"5432"}},
+			{Target: "redis.cache", Ports: []string{"6379"}},
+			{Target: "kafka.internal", Ports: []string{"9092-9094"}},
+		},
+	}, got)
+}
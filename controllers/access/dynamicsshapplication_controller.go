@@ -0,0 +1,185 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package access
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"bitbucket.org/accezz-io/sac-operator/service"
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
+
+	"bitbucket.org/accezz-io/sac-operator/controllers/access/converter"
+
+	"github.com/go-logr/logr"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DynamicSshApplicationReconciler reconciles a DynamicSshApplication object
+type DynamicSshApplicationReconciler struct {
+	client.Client
+	Scheme                   *runtime.Scheme
+	SecureAccessCloudClients *sac.SecureAccessCloudClientRegistry
+	Recorder                 record.EventRecorder
+	ResyncInterval           time.Duration
+	ConverterToModel         *converter.DynamicSshApplicationTypeConverter
+	Log                      logr.Logger
+}
+
+//+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=dynamicsshapplications,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=dynamicsshapplications/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=dynamicsshapplications/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+
+// Reconcile lists the pods selected by the DynamicSshApplication, converts them into the targets of a dynamic SSH
+// application model and reconciles it in Secure-Access-Cloud using the ApplicationService.
+func (r *DynamicSshApplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	application := &accessv1.DynamicSshApplication{}
+
+	if err := r.Get(ctx, req.NamespacedName, application); err != nil {
+		r.Log.Error(err, "unable to fetch application")
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	handler := newApplicationReconcileHandler(r.Client, r.SecureAccessCloudClients, r.Recorder, r.ResyncInterval, r.Log.WithValues("application", application.Name))
+	var pods []corev1.Pod
+	setStatus := func(output *service.ApplicationReconcileOutput, reconcileError error) {
+		application.Status = r.ConverterToModel.ConvertToStatus(application.Status, application.Generation, pods, output, reconcileError)
+	}
+
+	// an invalid spec must not block the deletion of an already created application
+	if application.ObjectMeta.DeletionTimestamp.IsZero() {
+		if err := r.ConverterToModel.Validate(application); err != nil {
+			r.Log.WithValues("application", application.Name).Error(err, "invalid application spec")
+			return handler.reportInvalidSpec(ctx, application, application.Status.Id, err, setStatus)
+		}
+
+		var err error
+		pods, err = r.listSelectedPods(ctx, application)
+		if err != nil {
+			r.Log.WithValues("application", application.Name).Error(err, "unable to list selected pods")
+			return ctrl.Result{}, err
+		}
+	}
+
+	model, err := r.ConverterToModel.ConvertToModel(application, pods)
+	if err != nil {
+		r.Log.Error(err, "convert to service model")
+		return ctrl.Result{}, nil
+	}
+
+	return handler.reconcile(ctx, application, model, setStatus)
+
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DynamicSshApplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := indexReferencedPolicies(mgr, &accessv1.DynamicSshApplication{}, func(rawObj client.Object) accessv1.CommonApplicationParams {
+		return rawObj.(*accessv1.DynamicSshApplication).Spec.CommonApplicationParams
+	}); err != nil {
+		return err
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&accessv1.DynamicSshApplication{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(
+			&source.Kind{Type: &corev1.Pod{}},
+			handler.EnqueueRequestsFromMapFunc(r.findApplicationsForPod),
+			builder.WithPredicates(podTargetChangedPredicate()),
+		)
+	return watchReferencedPolicies(b, r.Client, &accessv1.DynamicSshApplicationList{}, r.Log).
+		Complete(r)
+}
+
+func (r *DynamicSshApplicationReconciler) listSelectedPods(ctx context.Context, application *accessv1.DynamicSshApplication) ([]corev1.Pod, error) {
+
+	selector, err := metav1.LabelSelectorAsSelector(&application.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(application.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+
+	return pods.Items, nil
+}
+
+// findApplicationsForPod maps a pod to the DynamicSshApplications, in the pod's namespace, which select it.
+func (r *DynamicSshApplicationReconciler) findApplicationsForPod(object client.Object) []reconcile.Request {
+
+	applications := &accessv1.DynamicSshApplicationList{}
+	if err := r.List(context.Background(), applications, client.InNamespace(object.GetNamespace())); err != nil {
+		r.Log.Error(err, "unable to list applications for pod", "pod", object.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, application := range applications.Items {
+		selector, err := metav1.LabelSelectorAsSelector(&application.Spec.Selector)
+		if err != nil || selector.Empty() {
+			continue
+		}
+		if selector.Matches(labels.Set(object.GetLabels())) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: application.Name, Namespace: application.Namespace},
+			})
+		}
+	}
+
+	return requests
+}
+
+// podTargetChangedPredicate filters out the pod updates which do not change the way the pod is targeted, i.e. its
+// labels, phase, IP or deletion.
+func podTargetChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldPod, ok := e.ObjectOld.(*corev1.Pod)
+			if !ok {
+				return false
+			}
+			newPod, ok := e.ObjectNew.(*corev1.Pod)
+			if !ok {
+				return false
+			}
+			return !reflect.DeepEqual(oldPod.Labels, newPod.Labels) ||
+				oldPod.Status.Phase != newPod.Status.Phase ||
+				oldPod.Status.PodIP != newPod.Status.PodIP ||
+				oldPod.DeletionTimestamp.IsZero() != newPod.DeletionTimestamp.IsZero()
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package access
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	"bitbucket.org/accezz-io/sac-operator/service"
+)
+
+// The reasons of the events recorded by the reconcilers.
+const (
+	eventReasonReconcileFailed    = "ReconcileFailed"
+	eventReasonUnrecoverableError = "UnrecoverableError"
+	eventReasonTenantDeleted      = "TenantDeleted"
+)
+
+// objectEventRecorder records the events of the services on the reconciled object.
+type objectEventRecorder struct {
+	recorder record.EventRecorder
+	object   runtime.Object
+}
+
+func newObjectEventRecorder(recorder record.EventRecorder, object runtime.Object) service.EventRecorder {
+	return &objectEventRecorder{recorder: recorder, object: object}
+}
+
+func (r *objectEventRecorder) Eventf(eventType, reason, messageFmt string, args ...interface{}) {
+	r.recorder.Eventf(r.object, eventType, reason, messageFmt, args...)
+}
+
+// recordReconcileError records a warning event with the reconcile error, so the reason an object is not reconciled is
+// visible without access to the operator logs.
+func recordReconcileError(recorder record.EventRecorder, object runtime.Object, reconcileError error, unrecoverable bool) {
+	reason := eventReasonReconcileFailed
+	if unrecoverable {
+		reason = eventReasonUnrecoverableError
+	}
+	recorder.Event(object, corev1.EventTypeWarning, reason, reconcileError.Error())
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package access
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/controllers/access/converter"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const eventReasonInvalidExposedService = "InvalidExposedService"
+
+// ExposedServiceReconciler generates the HttpApplication exposing a Service annotated with
+// access.secure-access-cloud.symantec.com/expose=true in Secure-Access-Cloud. The generated application is owned by
+// its Service, so it is deleted with it.
+type ExposedServiceReconciler struct {
+	client.Client
+	Scheme    *runtime.Scheme
+	Recorder  record.EventRecorder
+	Converter *converter.ExposedServiceConverter
+	Log       logr.Logger
+}
+
+//+kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch
+
+// Reconcile creates or updates the HttpApplication of an exposed Service, and deletes it once the Service is no
+// longer exposed.
+func (r *ExposedServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	k8sService := &corev1.Service{}
+	if err := r.Get(ctx, req.NamespacedName, k8sService); err != nil {
+		if apierrors.IsNotFound(err) {
+			// the generated application is garbage collected with its owner
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "unable to fetch service")
+		return ctrl.Result{}, err
+	}
+
+	log := r.Log.WithValues("service", req.NamespacedName)
+
+	var desired []accessv1.HttpApplication
+	if r.Converter.IsExposed(k8sService) && k8sService.DeletionTimestamp.IsZero() {
+		application, err := r.Converter.ConvertToHttpApplication(k8sService)
+		if err != nil {
+			log.Error(err, "convert to http application")
+			r.Recorder.Event(k8sService, corev1.EventTypeWarning, eventReasonInvalidExposedService, err.Error())
+			// the service is reconciled again once it is fixed
+			return ctrl.Result{}, nil
+		}
+		desired = append(desired, *application)
+	}
+
+	handler := newGeneratedApplicationsHandler(r.Client, r.Scheme, r.Recorder, log)
+	if err := handler.sync(ctx, k8sService, converter.ServiceNameLabel, desired); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ExposedServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// services are not filtered by generation, their annotations configure the generated application and their first
+	// port is exposed by default
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("exposed-service").
+		For(&corev1.Service{}).
+		Owns(&accessv1.HttpApplication{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
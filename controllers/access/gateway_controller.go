@@ -0,0 +1,81 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package access
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// GatewayReconciler reports the Gateways of the operator GatewayClasses as scheduled and ready. The Gateway itself is
+// only an attachment point, the HTTPRoutes attached to it are exposed by the HTTPRouteReconciler.
+type GatewayReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	Log    logr.Logger
+}
+
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways/status,verbs=get;update;patch
+
+// Reconcile sets the Scheduled and Ready conditions of a Gateway of an operator GatewayClass. The Gateways of other
+// classes are ignored.
+func (r *GatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	gateway := &gatewayv1alpha2.Gateway{}
+	if err := r.Get(ctx, req.NamespacedName, gateway); err != nil {
+		r.Log.Error(err, "unable to fetch gateway")
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	managed, err := isManagedGatewayClass(ctx, r.Client, string(gateway.Spec.GatewayClassName))
+	if err != nil || !managed {
+		return ctrl.Result{}, err
+	}
+
+	for _, condition := range []metav1.Condition{
+		{Type: string(gatewayv1alpha2.GatewayConditionScheduled), Reason: string(gatewayv1alpha2.GatewayReasonScheduled)},
+		{Type: string(gatewayv1alpha2.GatewayConditionReady), Reason: string(gatewayv1alpha2.GatewayReasonReady)},
+	} {
+		condition.Status = metav1.ConditionTrue
+		condition.ObservedGeneration = gateway.Generation
+		meta.SetStatusCondition(&gateway.Status.Conditions, condition)
+	}
+	if err := r.Status().Update(ctx, gateway); err != nil {
+		r.Log.WithValues("gateway", req.NamespacedName).Error(err, "failed to update gateway status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *GatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&gatewayv1alpha2.Gateway{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
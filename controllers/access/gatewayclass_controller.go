@@ -0,0 +1,88 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package access
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	"bitbucket.org/accezz-io/sac-operator/controllers/access/converter"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// GatewayClassReconciler accepts the GatewayClasses of the operator controller name
+type GatewayClassReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	Log    logr.Logger
+}
+
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses,verbs=get;list;watch
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses/status,verbs=get;update;patch
+
+// Reconcile sets the Accepted condition of a GatewayClass of the operator controller name. The classes of other
+// controllers are ignored.
+func (r *GatewayClassReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	gatewayClass := &gatewayv1alpha2.GatewayClass{}
+	if err := r.Get(ctx, req.NamespacedName, gatewayClass); err != nil {
+		r.Log.Error(err, "unable to fetch gateway class")
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if gatewayClass.Spec.ControllerName != converter.GatewayControllerName {
+		return ctrl.Result{}, nil
+	}
+
+	meta.SetStatusCondition(&gatewayClass.Status.Conditions, metav1.Condition{
+		Type:               string(gatewayv1alpha2.GatewayClassConditionStatusAccepted),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gatewayClass.Generation,
+		Reason:             string(gatewayv1alpha2.GatewayClassReasonAccepted),
+	})
+	if err := r.Status().Update(ctx, gatewayClass); err != nil {
+		r.Log.WithValues("gatewayClass", gatewayClass.Name).Error(err, "failed to update gateway class status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *GatewayClassReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&gatewayv1alpha2.GatewayClass{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
+
+// isManagedGatewayClass returns whether the GatewayClass is of the operator controller name.
+func isManagedGatewayClass(ctx context.Context, c client.Reader, name string) (bool, error) {
+	gatewayClass := &gatewayv1alpha2.GatewayClass{}
+	if err := c.Get(ctx, client.ObjectKey{Name: name}, gatewayClass); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	return gatewayClass.Spec.ControllerName == converter.GatewayControllerName, nil
+}
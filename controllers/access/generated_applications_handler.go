@@ -0,0 +1,126 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	eventReasonHttpApplicationCreated = "HttpApplicationCreated"
+	eventReasonHttpApplicationUpdated = "HttpApplicationUpdated"
+	eventReasonHttpApplicationDeleted = "HttpApplicationDeleted"
+	eventReasonBackendNotExposed      = "BackendNotExposed"
+)
+
+// generatedApplicationsHandler keeps the HttpApplications generated from a kubernetes object (e.g. an Ingress or an
+// annotated Service) in sync with it. The generated applications are owned by the object, so they are garbage
+// collected with it.
+type generatedApplicationsHandler struct {
+	client.Client
+	scheme   *runtime.Scheme
+	recorder record.EventRecorder
+	log      logr.Logger
+}
+
+func newGeneratedApplicationsHandler(client client.Client, scheme *runtime.Scheme, recorder record.EventRecorder, log logr.Logger) *generatedApplicationsHandler {
+	return &generatedApplicationsHandler{Client: client, scheme: scheme, recorder: recorder, log: log}
+}
+
+// sync creates or updates the desired applications of the owner and deletes the applications generated from the
+// owner, i.e. labeled with ownerLabel=<owner name>, which are no longer desired. An application which cannot be
+// created or updated (e.g. rejected by the validating webhook) does not prevent the others from being synced, the
+// first error is returned once the applications which are no longer desired were deleted.
+func (h *generatedApplicationsHandler) sync(ctx context.Context, owner client.Object, ownerLabel string, desired []accessv1.HttpApplication) error {
+
+	var syncError error
+	desiredNames := map[string]bool{}
+	for i := range desired {
+		desiredNames[desired[i].Name] = true
+		if err := h.createOrUpdate(ctx, owner, &desired[i]); err != nil {
+			h.log.Error(err, "failed to create or update http application", "application", desired[i].Name)
+			h.recorder.Eventf(owner, corev1.EventTypeWarning, eventReasonReconcileFailed, "Failed to create or update HttpApplication %s: %s", desired[i].Name, err)
+			if syncError == nil {
+				syncError = err
+			}
+		}
+	}
+
+	existing := &accessv1.HttpApplicationList{}
+	if err := h.List(ctx, existing, client.InNamespace(owner.GetNamespace()), client.MatchingLabels{ownerLabel: owner.GetName()}); err != nil {
+		h.log.Error(err, "unable to list http applications")
+		return err
+	}
+	for i := range existing.Items {
+		application := &existing.Items[i]
+		if desiredNames[application.Name] || !metav1.IsControlledBy(application, owner) {
+			continue
+		}
+		if err := h.Delete(ctx, application); client.IgnoreNotFound(err) != nil {
+			h.log.Error(err, "failed to delete http application", "application", application.Name)
+			return err
+		}
+		h.recorder.Eventf(owner, corev1.EventTypeNormal, eventReasonHttpApplicationDeleted, "Deleted HttpApplication %s", application.Name)
+	}
+
+	return syncError
+}
+
+// recordUnexposedBackends records a warning event on the owner with the backends which are not exposed by its
+// generated applications, as a domain is exposed by a single application.
+func recordUnexposedBackends(recorder record.EventRecorder, owner client.Object, unexposedBackends []string) {
+	if len(unexposedBackends) == 0 {
+		return
+	}
+	recorder.Eventf(owner, corev1.EventTypeWarning, eventReasonBackendNotExposed,
+		"Only one backend is exposed per host, not exposed: %s", strings.Join(unexposedBackends, ", "))
+}
+
+func (h *generatedApplicationsHandler) createOrUpdate(ctx context.Context, owner client.Object, desired *accessv1.HttpApplication) error {
+
+	application := &accessv1.HttpApplication{}
+	application.Name = desired.Name
+	application.Namespace = desired.Namespace
+	result, err := controllerutil.CreateOrUpdate(ctx, h.Client, application, func() error {
+		if !application.CreationTimestamp.IsZero() && !metav1.IsControlledBy(application, owner) {
+			return fmt.Errorf("http application %s already exists and is not generated from %s", application.Name, owner.GetName())
+		}
+		if application.Labels == nil {
+			application.Labels = map[string]string{}
+		}
+		for key, value := range desired.Labels {
+			application.Labels[key] = value
+		}
+		// the fields which are not generated (e.g. drift_policy) keep their defaults
+		application.Spec.CommonApplicationParams.SiteName = desired.Spec.SiteName
+		application.Spec.CommonApplicationParams.TenantRef = desired.Spec.TenantRef
+		application.Spec.CommonApplicationParams.AccessPoliciesNames = desired.Spec.AccessPoliciesNames
+		application.Spec.CommonApplicationParams.ActivityPoliciesNames = desired.Spec.ActivityPoliciesNames
+		application.Spec.SubType = desired.Spec.SubType
+		application.Spec.Service = desired.Spec.Service
+		application.Spec.HttpConnectionSettings = desired.Spec.HttpConnectionSettings
+		return controllerutil.SetControllerReference(owner, application, h.scheme)
+	})
+	if err != nil {
+		return err
+	}
+
+	switch result {
+	case controllerutil.OperationResultCreated:
+		h.recorder.Eventf(owner, corev1.EventTypeNormal, eventReasonHttpApplicationCreated, "Created HttpApplication %s", application.Name)
+	case controllerutil.OperationResultUpdated:
+		h.recorder.Eventf(owner, corev1.EventTypeNormal, eventReasonHttpApplicationUpdated, "Updated HttpApplication %s", application.Name)
+	}
+	return nil
+}
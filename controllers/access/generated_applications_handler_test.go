@@ -0,0 +1,107 @@
+package access
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+)
+
+const testOwnerLabel = "access.secure-access-cloud.symantec.com/service"
+
+func generatedApplication(name, siteName string) accessv1.HttpApplication {
+	return accessv1.HttpApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "apps", Labels: map[string]string{testOwnerLabel: "web"}},
+		Spec: accessv1.HttpApplicationSpec{
+			CommonApplicationParams: accessv1.CommonApplicationParams{SiteName: siteName},
+			Service:                 accessv1.Service{Name: "web", Port: "80"},
+		},
+	}
+}
+
+func TestGeneratedApplicationsHandler_sync(t *testing.T) {
+	// given
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, accessv1.AddToScheme(scheme))
+	owner := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "apps", UID: "owner-uid"}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(owner).Build()
+	handler := newGeneratedApplicationsHandler(c, scheme, record.NewFakeRecorder(10), logr.Discard())
+	ctx := context.Background()
+
+	// when
+	require.NoError(t, handler.sync(ctx, owner, testOwnerLabel, []accessv1.HttpApplication{
+		generatedApplication("web-a", "my-site"),
+		generatedApplication("web-b", "my-site"),
+	}))
+	require.NoError(t, handler.sync(ctx, owner, testOwnerLabel, []accessv1.HttpApplication{
+		generatedApplication("web-a", "other-site"),
+	}))
+
+	// then
+	applications := &accessv1.HttpApplicationList{}
+	require.NoError(t, c.List(ctx, applications, client.InNamespace("apps")))
+	require.Len(t, applications.Items, 1)
+	assert.Equal(t, "web-a", applications.Items[0].Name)
+	assert.Equal(t, "other-site", applications.Items[0].Spec.SiteName)
+	assert.True(t, metav1.IsControlledBy(&applications.Items[0], owner))
+}
+
+func TestGeneratedApplicationsHandler_sync_ExistingApplication(t *testing.T) {
+	// given an application with the same name which was not generated from the owner
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, accessv1.AddToScheme(scheme))
+	owner := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "apps", UID: "owner-uid"}}
+	existing := generatedApplication("web", "my-site")
+	existing.CreationTimestamp = metav1.Now()
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(owner, &existing).Build()
+	handler := newGeneratedApplicationsHandler(c, scheme, record.NewFakeRecorder(10), logr.Discard())
+
+	// when
+	err := handler.sync(context.Background(), owner, testOwnerLabel, []accessv1.HttpApplication{generatedApplication("web", "other-site")})
+
+	// then
+	assert.Error(t, err)
+}
+
+func TestGeneratedApplicationsHandler_sync_FailedApplication(t *testing.T) {
+	// given an application which cannot be updated and a generated application which is no longer desired
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, accessv1.AddToScheme(scheme))
+	owner := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "apps", UID: "owner-uid"}}
+	existing := generatedApplication("web-a", "my-site")
+	existing.CreationTimestamp = metav1.Now()
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(owner, &existing).Build()
+	handler := newGeneratedApplicationsHandler(c, scheme, record.NewFakeRecorder(10), logr.Discard())
+	ctx := context.Background()
+	require.NoError(t, handler.sync(ctx, owner, testOwnerLabel, []accessv1.HttpApplication{generatedApplication("web-b", "my-site")}))
+
+	// when
+	err := handler.sync(ctx, owner, testOwnerLabel, []accessv1.HttpApplication{
+		generatedApplication("web-a", "other-site"),
+		generatedApplication("web-c", "my-site"),
+	})
+
+	// then the other applications are synced, and the one which is no longer desired is deleted
+	assert.Error(t, err)
+	applications := &accessv1.HttpApplicationList{}
+	require.NoError(t, c.List(ctx, applications, client.InNamespace("apps")))
+	var names []string
+	for _, application := range applications.Items {
+		names = append(names, application.Name)
+	}
+	assert.ElementsMatch(t, []string{"web-a", "web-c"}, names)
+}
@@ -18,39 +18,50 @@ package access
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
-	"bitbucket.org/accezz-io/sac-operator/utils/typederror"
-
-	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
-
 	"bitbucket.org/accezz-io/sac-operator/service"
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
 
 	"bitbucket.org/accezz-io/sac-operator/controllers/access/converter"
 
 	"github.com/go-logr/logr"
 
 	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
+// certificateSecretRefKey is the field index of the applications by the namespace/name of their custom certificate
+// secret
+const certificateSecretRefKey = ".spec.http_connection_settings.custom_ssl_certificate_secret_name"
+
 // HttpApplicationReconciler reconciles a HttpApplication object
 type HttpApplicationReconciler struct {
 	client.Client
-	Scheme             *runtime.Scheme
-	ApplicationService service.ApplicationService
-	ConverterToModel   *converter.HttpApplicationTypeConverter
-	Log                logr.Logger
+	Scheme                   *runtime.Scheme
+	SecureAccessCloudClients *sac.SecureAccessCloudClientRegistry
+	Recorder                 record.EventRecorder
+	ResyncInterval           time.Duration
+	ConverterToModel         *converter.HttpApplicationTypeConverter
+	Log                      logr.Logger
 }
 
 //+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=httpapplications,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=httpapplications/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=httpapplications/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -70,62 +81,102 @@ func (r *HttpApplicationReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	model, err := r.ConverterToModel.ConvertToModel(application)
+	servicePort, serviceReason, serviceMessage, err := resolveReferencedService(ctx, r.Client, application.Spec.Service, application.Namespace)
+	if err != nil {
+		r.Log.Error(err, "unable to fetch the exposed service")
+		return ctrl.Result{}, err
+	}
+
+	model, err := r.ConverterToModel.ConvertToModel(application, servicePort)
 	if err != nil {
 		r.Log.Error(err, "convert to service model")
 		return ctrl.Result{}, nil
 	}
-	output, err := r.ApplicationService.Reconcile(ctx, model)
-	if !controllerutil.ContainsFinalizer(application, applicationFinalizerName) && output.SACApplicationID != "" {
-		controllerutil.AddFinalizer(application, applicationFinalizerName)
-		if err := r.Update(ctx, application); err != nil {
-			r.Log.WithValues("application", application.Name).Info("failed to add finalizer")
+
+	if secretName := r.certificateSecretName(application); secretName != "" && !model.ToDelete {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: secretName, Namespace: application.Namespace}, secret); err != nil {
+			r.Log.Error(err, "unable to fetch the custom certificate secret", "secret", secretName)
 			return ctrl.Result{}, err
 		}
+		if err := r.ConverterToModel.ConvertCertificateSecret(model, secret); err != nil {
+			r.Log.Error(err, "convert the custom certificate secret")
+			return ctrl.Result{}, nil
+		}
 	}
-	return r.handleReconcilerReturn(ctx, application, output, err)
 
-}
+	handler := newApplicationReconcileHandler(r.Client, r.SecureAccessCloudClients, r.Recorder, r.ResyncInterval, r.Log.WithValues("application", application.Name))
+	return handler.reconcile(ctx, application, model, func(output *service.ApplicationReconcileOutput, reconcileError error) {
+		application.Status = r.ConverterToModel.ConvertFromServiceOutput(application.Status, application.Generation, output, reconcileError)
+		r.ConverterToModel.SetServiceCondition(&application.Status, application.Generation, serviceReason, serviceMessage)
+		application.Status.InternalAddress = model.ConnectionSettings.InternalAddress
+	})
 
-// SetupWithManager sets up the controller with the Manager.
-func (r *HttpApplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&accessv1.HttpApplication{}).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
-		Complete(r)
 }
 
-func (r *HttpApplicationReconciler) handleReconcilerReturn(ctx context.Context, application *accessv1.HttpApplication, output *service.ApplicationReconcileOutput, reconcileError error) (ctrl.Result, error) {
-
-	if errors.Is(reconcileError, typederror.UnrecoverableError) {
-		r.Log.WithValues("application", application.Name).Error(reconcileError, "got unrecoverable error, giving up...")
-		return ctrl.Result{Requeue: false}, nil
+func (r *HttpApplicationReconciler) certificateSecretName(application *accessv1.HttpApplication) string {
+	if application.Spec.HttpConnectionSettings == nil {
+		return ""
 	}
+	return application.Spec.HttpConnectionSettings.CustomSSLCertificateSecretName
+}
 
-	if output.Deleted {
-		controllerutil.RemoveFinalizer(application, applicationFinalizerName)
-		if err := r.Update(ctx, application); err != nil {
-			r.Log.WithValues("application", application.Name).Error(err, "failed to remove Finalizer from application")
-			return ctrl.Result{}, err
+// SetupWithManager sets up the controller with the Manager.
+func (r *HttpApplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &accessv1.HttpApplication{}, serviceRefKey, func(rawObj client.Object) []string {
+		application := rawObj.(*accessv1.HttpApplication)
+		return []string{serviceRefKeyValue(application.Spec.Service, application.Namespace)}
+	}); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &accessv1.HttpApplication{}, certificateSecretRefKey, func(rawObj client.Object) []string {
+		application := rawObj.(*accessv1.HttpApplication)
+		secretName := r.certificateSecretName(application)
+		if secretName == "" {
+			return nil
 		}
-		return ctrl.Result{}, nil
+		return []string{types.NamespacedName{Name: secretName, Namespace: application.Namespace}.String()}
+	}); err != nil {
+		return err
+	}
+	if err := indexReferencedPolicies(mgr, &accessv1.HttpApplication{}, func(rawObj client.Object) accessv1.CommonApplicationParams {
+		return rawObj.(*accessv1.HttpApplication).Spec.CommonApplicationParams
+	}); err != nil {
+		return err
 	}
 
-	application.Status = r.ConverterToModel.ConvertFromServiceOutput(output)
+	// the generation predicate is applied only to the applications, the Services and the certificate Secrets are
+	// watched for any change
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&accessv1.HttpApplication{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &corev1.Service{}}, handler.EnqueueRequestsFromMapFunc(r.findApplicationsForService)).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(r.findApplicationsForSecret))
+	return watchReferencedPolicies(b, r.Client, &accessv1.HttpApplicationList{}, r.Log).
+		Complete(r)
+}
+
+// findApplicationsForService maps a Service to the applications exposing it.
+func (r *HttpApplicationReconciler) findApplicationsForService(k8sService client.Object) []reconcile.Request {
+	return r.findApplications(serviceRefKey, k8sService)
+}
 
-	if reconcileError != nil {
-		r.Log.WithValues("application", application.Name).Error(reconcileError, "failed to reconcile, trying to update last known status")
-	}
+// findApplicationsForSecret maps a Secret to the applications using it as their custom certificate.
+func (r *HttpApplicationReconciler) findApplicationsForSecret(secret client.Object) []reconcile.Request {
+	return r.findApplications(certificateSecretRefKey, secret)
+}
 
-	err := r.Status().Update(ctx, application)
-	if err != nil {
-		r.Log.WithValues("application", application.Name).Error(reconcileError, "failed to update application status, retrying in 5 seconds")
-		return ctrl.Result{RequeueAfter: 5 * time.Second}, err
+// findApplications maps an object to the applications referencing it, by the given field index.
+func (r *HttpApplicationReconciler) findApplications(field string, object client.Object) []reconcile.Request {
+	applications := &accessv1.HttpApplicationList{}
+	key := types.NamespacedName{Name: object.GetName(), Namespace: object.GetNamespace()}.String()
+	if err := r.List(context.Background(), applications, client.MatchingFields{field: key}); err != nil {
+		r.Log.Error(err, "unable to list applications", "object", key)
+		return nil
 	}
 
-	if reconcileError != nil {
-		return ctrl.Result{RequeueAfter: 5 * time.Second}, reconcileError
+	requests := make([]reconcile.Request, 0, len(applications.Items))
+	for _, application := range applications.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: application.Name, Namespace: application.Namespace}})
 	}
-
-	return ctrl.Result{Requeue: false}, nil
+	return requests
 }
@@ -0,0 +1,234 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package access
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/go-logr/logr"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/controllers/access/converter"
+	"bitbucket.org/accezz-io/sac-operator/model"
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
+	"bitbucket.org/accezz-io/sac-operator/utils"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HttpApplicationValidator validates the HttpApplications at apply time, so an invalid spec is rejected instead of
+// failing the reconcile with an UnrecoverableError.
+type HttpApplicationValidator struct {
+	client.Client
+	SecureAccessCloudClients *sac.SecureAccessCloudClientRegistry
+	Converter                *converter.HttpApplicationTypeConverter
+	// when set, the referenced site and policies are looked up in Secure-Access-Cloud
+	VerifySecureAccessCloudReferences bool
+	Log                               logr.Logger
+}
+
+//+kubebuilder:webhook:path=/validate-access-secure-access-cloud-symantec-com-v1-httpapplication,mutating=false,failurePolicy=fail,sideEffects=None,groups=access.secure-access-cloud.symantec.com,resources=httpapplications,verbs=create;update,versions=v1,name=vhttpapplication.kb.io,admissionReviewVersions=v1
+
+// SetupWebhookWithManager registers the validating webhook with the Manager.
+func (v *HttpApplicationValidator) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&accessv1.HttpApplication{}).
+		WithValidator(v).
+		Complete()
+}
+
+// ValidateCreate validates the fields of a new application and that its domain is not used by another application.
+func (v *HttpApplicationValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	application := obj.(*accessv1.HttpApplication)
+
+	if err := validateInlineCertificate(nil, application); err != nil {
+		return v.reject(application, err)
+	}
+	return v.validate(ctx, application)
+}
+
+// ValidateUpdate validates the fields of an updated application, including the fields which cannot be changed once
+// the application is created.
+func (v *HttpApplicationValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) error {
+	oldApplication, application := oldObj.(*accessv1.HttpApplication), newObj.(*accessv1.HttpApplication)
+
+	if !application.DeletionTimestamp.IsZero() {
+		// let the finalizer be removed from an application which is being deleted
+		return nil
+	}
+	if reflect.DeepEqual(oldApplication.Spec, application.Spec) {
+		// a metadata or status only update, e.g. the finalizer being added
+		return nil
+	}
+
+	oldSubType := utils.GetApplicationSubTypeOrDefault(oldApplication.Spec.SubType, model.DefaultSubType)
+	subType := utils.GetApplicationSubTypeOrDefault(application.Spec.SubType, model.DefaultSubType)
+	if oldSubType != subType {
+		return v.reject(application, fmt.Errorf("sub_type cannot be changed from %s to %s", oldSubType, subType))
+	}
+	if oldApplication.Spec.TenantRef != application.Spec.TenantRef {
+		return v.reject(application, fmt.Errorf("tenant cannot be changed from %q to %q", oldApplication.Spec.TenantRef, application.Spec.TenantRef))
+	}
+	if err := validateInlineCertificate(oldApplication, application); err != nil {
+		return v.reject(application, err)
+	}
+
+	return v.validate(ctx, application)
+}
+
+// ValidateDelete allows any application to be deleted.
+func (v *HttpApplicationValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+func (v *HttpApplicationValidator) validate(ctx context.Context, application *accessv1.HttpApplication) error {
+
+	if err := v.Converter.Validate(application); err != nil {
+		return v.reject(application, err)
+	}
+	if err := v.validateUniqueDomain(ctx, application); err != nil {
+		return v.reject(application, err)
+	}
+	if v.VerifySecureAccessCloudReferences {
+		if err := v.validateSecureAccessCloudReferences(ctx, application); err != nil {
+			return v.reject(application, err)
+		}
+	}
+	return nil
+}
+
+// validateUniqueDomain validates that no other application of the same tenant in the cluster uses the subdomain or
+// the custom external address of the application.
+func (v *HttpApplicationValidator) validateUniqueDomain(ctx context.Context, application *accessv1.HttpApplication) error {
+
+	subDomain, externalAddress := httpApplicationDomain(application)
+	if subDomain == "" && externalAddress == "" {
+		return nil
+	}
+
+	applications := &accessv1.HttpApplicationList{}
+	if err := v.List(ctx, applications); err != nil {
+		return err
+	}
+	for i := range applications.Items {
+		other := &applications.Items[i]
+		if (other.Name == application.Name && other.Namespace == application.Namespace) || other.Spec.TenantRef != application.Spec.TenantRef {
+			continue
+		}
+		otherSubDomain, otherExternalAddress := httpApplicationDomain(other)
+		if subDomain != "" && subDomain == otherSubDomain {
+			return fmt.Errorf("subdomain %s is already used by application %s/%s", subDomain, other.Namespace, other.Name)
+		}
+		if externalAddress != "" && externalAddress == otherExternalAddress {
+			return fmt.Errorf("custom_external_address %s is already used by application %s/%s", externalAddress, other.Namespace, other.Name)
+		}
+	}
+	return nil
+}
+
+// validateSecureAccessCloudReferences validates that the site and the policies referenced by the application exist
+// in Secure-Access-Cloud, and that the policies are of the kind they are referenced as. A policy managed by an
+// AccessPolicy or an ActivityPolicy of the application namespace is accepted even if it was not created in
+// Secure-Access-Cloud yet.
+func (v *HttpApplicationValidator) validateSecureAccessCloudReferences(ctx context.Context, application *accessv1.HttpApplication) error {
+
+	sacClient, err := v.SecureAccessCloudClients.Get(application.Spec.TenantRef)
+	if err != nil {
+		return err
+	}
+
+	if _, err := sacClient.FindSiteByName(ctx, application.Spec.SiteName); err != nil {
+		return fmt.Errorf("site %s: %w", application.Spec.SiteName, err)
+	}
+
+	for _, policyName := range application.Spec.AccessPoliciesNames {
+		if err := v.validatePolicy(ctx, sacClient, model.AccessPolicy, &accessv1.AccessPolicy{}, &accessv1.ActivityPolicy{}, application.Namespace, policyName); err != nil {
+			return fmt.Errorf("access policy %s: %w", policyName, err)
+		}
+	}
+	for _, policyName := range application.Spec.ActivityPoliciesNames {
+		if err := v.validatePolicy(ctx, sacClient, model.ActivityPolicy, &accessv1.ActivityPolicy{}, &accessv1.AccessPolicy{}, application.Namespace, policyName); err != nil {
+			return fmt.Errorf("activity policy %s: %w", policyName, err)
+		}
+	}
+	return nil
+}
+
+// validatePolicy validates a policy referenced as a policy of the given type. policy is the object of the kind managing
+// such policies in the cluster, and otherPolicy the object of the other policy kind.
+func (v *HttpApplicationValidator) validatePolicy(ctx context.Context, sacClient sac.SecureAccessCloudClient, policyType model.PolicyType, policy, otherPolicy client.Object, namespace, name string) error {
+
+	key := types.NamespacedName{Namespace: namespace, Name: name}
+	err := v.Get(ctx, key, policy)
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	// the policy of the other kind would be found by name in Secure-Access-Cloud, even before it is created there
+	err = v.Get(ctx, key, otherPolicy)
+	if err == nil {
+		return fmt.Errorf("is managed by an %s", policyKind(otherPolicy))
+	}
+	if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	_, err = sacClient.FindPolicyByName(ctx, policyType, name)
+	return err
+}
+
+// validateInlineCertificate rejects an inline custom_ssl_certificate or wildcard_private_key which is set or changed,
+// the certificate is referenced by custom_ssl_certificate_secret_name instead. The inline keys of the applications
+// created before are kept, they are stored in annotations of the v2 applications.
+func validateInlineCertificate(oldApplication, application *accessv1.HttpApplication) error {
+	var oldSettings, settings accessv1.HttpConnectionSettings
+	if oldApplication != nil && oldApplication.Spec.HttpConnectionSettings != nil {
+		oldSettings = *oldApplication.Spec.HttpConnectionSettings
+	}
+	if application.Spec.HttpConnectionSettings != nil {
+		settings = *application.Spec.HttpConnectionSettings
+	}
+
+	if settings.CustomSSLCertificate != "" && settings.CustomSSLCertificate != oldSettings.CustomSSLCertificate {
+		return fmt.Errorf("inline custom_ssl_certificate is not supported, store it in a kubernetes.io/tls Secret referenced by custom_ssl_certificate_secret_name")
+	}
+	if settings.WildcardPrivateKey != "" && settings.WildcardPrivateKey != oldSettings.WildcardPrivateKey {
+		return fmt.Errorf("inline wildcard_private_key is not supported, store it in a kubernetes.io/tls Secret referenced by custom_ssl_certificate_secret_name")
+	}
+	return nil
+}
+
+func httpApplicationDomain(application *accessv1.HttpApplication) (subDomain, externalAddress string) {
+	if application.Spec.HttpConnectionSettings == nil {
+		return "", ""
+	}
+	return application.Spec.HttpConnectionSettings.SubDomain, application.Spec.HttpConnectionSettings.CustomExternalAddress
+}
+
+func (v *HttpApplicationValidator) reject(application *accessv1.HttpApplication, err error) error {
+	v.Log.Info("rejecting application", "namespace", application.Namespace, "name", application.Name, "reason", err.Error())
+	return apierrors.NewForbidden(accessv1.GroupVersion.WithResource("httpapplications").GroupResource(), application.Name, err)
+}
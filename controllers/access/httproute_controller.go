@@ -0,0 +1,216 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package access
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/go-logr/logr"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/controllers/access/converter"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+const eventReasonInvalidHTTPRoute = "InvalidHTTPRoute"
+
+// HTTPRouteReconciler generates the HttpApplications exposing the backends of the HTTPRoutes attached to a Gateway of
+// an operator GatewayClass in Secure-Access-Cloud, and reports the reconcile result of the generated applications in
+// the route status. The generated applications are owned by their route, so they are deleted with it.
+type HTTPRouteReconciler struct {
+	client.Client
+	Scheme    *runtime.Scheme
+	Recorder  record.EventRecorder
+	Converter *converter.HTTPRouteConverter
+	Log       logr.Logger
+}
+
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes/status,verbs=get;update;patch
+
+// Reconcile creates or updates the HttpApplications of the HTTPRoute, deletes those which no longer match one of its
+// hostnames and backends (or all of them when the route is detached from the operator Gateways) and writes the
+// Accepted and ResolvedRefs conditions of the operator Gateways in the route status.
+func (r *HTTPRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	route := &gatewayv1alpha2.HTTPRoute{}
+	if err := r.Get(ctx, req.NamespacedName, route); err != nil {
+		if apierrors.IsNotFound(err) {
+			// the generated applications are garbage collected with their owner
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "unable to fetch http route")
+		return ctrl.Result{}, err
+	}
+
+	log := r.Log.WithValues("httproute", req.NamespacedName)
+
+	parentRefs, gateway, err := r.managedParents(ctx, route)
+	if err != nil {
+		log.Error(err, "unable to fetch the route gateways")
+		return ctrl.Result{}, err
+	}
+
+	var desired []accessv1.HttpApplication
+	var unresolvedRefs, unexposedRefs []string
+	var convertError error
+	if len(parentRefs) > 0 && route.DeletionTimestamp.IsZero() {
+		desired, unresolvedRefs, unexposedRefs, convertError = r.Converter.ConvertToHttpApplications(route, gateway)
+		if convertError != nil {
+			log.Error(convertError, "convert to http applications")
+			r.Recorder.Event(route, corev1.EventTypeWarning, eventReasonInvalidHTTPRoute, convertError.Error())
+		}
+		recordUnexposedBackends(r.Recorder, route, unexposedRefs)
+	}
+
+	// the applications of an invalid route are kept until it is fixed
+	if convertError == nil {
+		handler := newGeneratedApplicationsHandler(r.Client, r.Scheme, r.Recorder, log)
+		if err := handler.sync(ctx, route, converter.HTTPRouteNameLabel, desired); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	applications := &accessv1.HttpApplicationList{}
+	if err := r.List(ctx, applications, client.InNamespace(route.Namespace), client.MatchingLabels{converter.HTTPRouteNameLabel: route.Name}); err != nil {
+		log.Error(err, "unable to list http applications")
+		return ctrl.Result{}, err
+	}
+
+	r.setParentsStatus(route, parentRefs, applications.Items, unresolvedRefs, convertError)
+	if err := r.Status().Update(ctx, route); err != nil {
+		log.Error(err, "failed to update http route status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// managedParents returns the parentRefs of the route which are Gateways of an operator GatewayClass, and the first of
+// these Gateways.
+func (r *HTTPRouteReconciler) managedParents(ctx context.Context, route *gatewayv1alpha2.HTTPRoute) ([]gatewayv1alpha2.ParentRef, *gatewayv1alpha2.Gateway, error) {
+
+	var parentRefs []gatewayv1alpha2.ParentRef
+	var firstGateway *gatewayv1alpha2.Gateway
+	for _, parentRef := range route.Spec.ParentRefs {
+		if (parentRef.Group != nil && *parentRef.Group != gatewayv1alpha2.GroupName) || (parentRef.Kind != nil && *parentRef.Kind != "Gateway") {
+			continue
+		}
+		gateway := &gatewayv1alpha2.Gateway{}
+		if err := r.Get(ctx, parentGatewayKey(parentRef, route.Namespace), gateway); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, nil, err
+		}
+		managed, err := isManagedGatewayClass(ctx, r.Client, string(gateway.Spec.GatewayClassName))
+		if err != nil {
+			return nil, nil, err
+		}
+		if !managed {
+			continue
+		}
+		parentRefs = append(parentRefs, parentRef)
+		if firstGateway == nil {
+			firstGateway = gateway
+		}
+	}
+	return parentRefs, firstGateway, nil
+}
+
+// setParentsStatus sets the status of the operator parents of the route, the statuses of the parents of other
+// controllers are kept.
+func (r *HTTPRouteReconciler) setParentsStatus(route *gatewayv1alpha2.HTTPRoute, parentRefs []gatewayv1alpha2.ParentRef, applications []accessv1.HttpApplication, unresolvedRefs []string, convertError error) {
+
+	var parents []gatewayv1alpha2.RouteParentStatus
+	current := map[int]gatewayv1alpha2.RouteParentStatus{}
+	for _, parent := range route.Status.Parents {
+		if parent.ControllerName != converter.GatewayControllerName {
+			parents = append(parents, parent)
+			continue
+		}
+		for i, parentRef := range parentRefs {
+			if reflect.DeepEqual(parent.ParentRef, parentRef) {
+				current[i] = parent
+			}
+		}
+	}
+
+	for i, parentRef := range parentRefs {
+		parents = append(parents, gatewayv1alpha2.RouteParentStatus{
+			ParentRef:      parentRef,
+			ControllerName: converter.GatewayControllerName,
+			Conditions:     r.Converter.ConvertToRouteConditions(current[i].Conditions, route.Generation, applications, unresolvedRefs, convertError),
+		})
+	}
+	route.Status.Parents = parents
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *HTTPRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// the generated applications are watched for any change, as their status is reported in the route status
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&gatewayv1alpha2.HTTPRoute{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		Owns(&accessv1.HttpApplication{}).
+		Watches(&source.Kind{Type: &gatewayv1alpha2.Gateway{}}, handler.EnqueueRequestsFromMapFunc(r.findRoutesForGateway)).
+		Complete(r)
+}
+
+// findRoutesForGateway maps a Gateway to the routes attached to it.
+func (r *HTTPRouteReconciler) findRoutesForGateway(gateway client.Object) []reconcile.Request {
+	routes := &gatewayv1alpha2.HTTPRouteList{}
+	if err := r.List(context.Background(), routes); err != nil {
+		r.Log.Error(err, "unable to list http routes", "gateway", gateway.GetName())
+		return nil
+	}
+
+	gatewayKey := types.NamespacedName{Name: gateway.GetName(), Namespace: gateway.GetNamespace()}
+	var requests []reconcile.Request
+	for _, route := range routes.Items {
+		for _, parentRef := range route.Spec.ParentRefs {
+			if parentGatewayKey(parentRef, route.Namespace) == gatewayKey {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: route.Name, Namespace: route.Namespace}})
+				break
+			}
+		}
+	}
+	return requests
+}
+
+// parentGatewayKey returns the key of the Gateway referenced by the parentRef, the Gateway namespace defaults to the
+// route namespace.
+func parentGatewayKey(parentRef gatewayv1alpha2.ParentRef, routeNamespace string) types.NamespacedName {
+	namespace := routeNamespace
+	if parentRef.Namespace != nil {
+		namespace = string(*parentRef.Namespace)
+	}
+	return types.NamespacedName{Name: string(parentRef.Name), Namespace: namespace}
+}
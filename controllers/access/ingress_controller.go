@@ -0,0 +1,98 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package access
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/controllers/access/converter"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const eventReasonInvalidIngress = "InvalidIngress"
+
+// IngressReconciler generates the HttpApplications exposing the backends of the Ingresses of the operator
+// IngressClass (or annotated to be exposed) in Secure-Access-Cloud. The generated applications are owned by their
+// Ingress, so they are deleted with it.
+type IngressReconciler struct {
+	client.Client
+	Scheme    *runtime.Scheme
+	Recorder  record.EventRecorder
+	Converter *converter.IngressConverter
+	Log       logr.Logger
+}
+
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch
+
+// Reconcile creates or updates the HttpApplications of the Ingress and deletes those which no longer match one of
+// its hosts and backends, or all of them when the Ingress is no longer exposed.
+func (r *IngressReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	ingress := &networkingv1.Ingress{}
+	if err := r.Get(ctx, req.NamespacedName, ingress); err != nil {
+		if apierrors.IsNotFound(err) {
+			// the generated applications are garbage collected with their owner
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "unable to fetch ingress")
+		return ctrl.Result{}, err
+	}
+
+	log := r.Log.WithValues("ingress", req.NamespacedName)
+
+	var desired []accessv1.HttpApplication
+	if r.Converter.IsExposed(ingress) && ingress.DeletionTimestamp.IsZero() {
+		var unexposedBackends []string
+		var err error
+		desired, unexposedBackends, err = r.Converter.ConvertToHttpApplications(ingress)
+		if err != nil {
+			log.Error(err, "convert to http applications")
+			r.Recorder.Event(ingress, corev1.EventTypeWarning, eventReasonInvalidIngress, err.Error())
+			// the ingress is reconciled again once it is fixed
+			return ctrl.Result{}, nil
+		}
+		recordUnexposedBackends(r.Recorder, ingress, unexposedBackends)
+	}
+
+	handler := newGeneratedApplicationsHandler(r.Client, r.Scheme, r.Recorder, log)
+	if err := handler.sync(ctx, ingress, converter.IngressNameLabel, desired); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *IngressReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// the ingress annotations configure the generated applications, so their changes are reconciled as well
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&networkingv1.Ingress{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		Owns(&accessv1.HttpApplication{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,108 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"bitbucket.org/accezz-io/sac-operator/model"
+	"bitbucket.org/accezz-io/sac-operator/service"
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
+	"bitbucket.org/accezz-io/sac-operator/utils/typederror"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// policyReconcileHandler holds the reconcile steps shared by all the policy kinds: it drives the PolicyService of the
+// policy tenant with the converted model, manages the finalizer and writes back the policy status.
+type policyReconcileHandler struct {
+	client.Client
+	sacClients *sac.SecureAccessCloudClientRegistry
+	recorder   record.EventRecorder
+	log        logr.Logger
+}
+
+func newPolicyReconcileHandler(client client.Client, sacClients *sac.SecureAccessCloudClientRegistry, recorder record.EventRecorder, log logr.Logger) *policyReconcileHandler {
+	return &policyReconcileHandler{Client: client, sacClients: sacClients, recorder: recorder, log: log}
+}
+
+// reconcile reconciles the policy model of the given object in Secure-Access-Cloud. setStatus is called with the
+// reconcile output and error in order to update the object status, including its conditions, before it is written
+// back to the cluster.
+func (h *policyReconcileHandler) reconcile(ctx context.Context, object client.Object, policy *model.Policy, setStatus func(output *service.PolicyReconcileOutput, reconcileError error)) (ctrl.Result, error) {
+
+	sacClient, err := h.sacClients.Get(policy.TenantName)
+	if err != nil {
+		output := &service.PolicyReconcileOutput{SACPolicyID: policy.ID}
+		if policy.ToDelete && errors.Is(err, sac.ErrorTenantNotFound) {
+			if deleted, tenantErr := tenantDeleted(ctx, h.Client, policy.TenantName); tenantErr != nil {
+				err = tenantErr
+			} else if deleted {
+				h.recorder.Eventf(object, corev1.EventTypeWarning, eventReasonTenantDeleted,
+					"tenant %s was deleted, policy %s is left in Secure-Access-Cloud", policy.TenantName, policy.ID)
+				output.Deleted, err = true, nil
+			}
+		}
+		return h.handleReconcilerReturn(ctx, object, output, err, setStatus)
+	}
+
+	output, err := service.NewPolicyServiceImpl(sacClient, h.log).
+		SetEventRecorder(newObjectEventRecorder(h.recorder, object)).
+		Reconcile(ctx, policy)
+	if !controllerutil.ContainsFinalizer(object, policyFinalizerName) && output.SACPolicyID != "" {
+		controllerutil.AddFinalizer(object, policyFinalizerName)
+		if err := h.Update(ctx, object); err != nil {
+			h.log.Info("failed to add finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+	return h.handleReconcilerReturn(ctx, object, output, err, setStatus)
+}
+
+func (h *policyReconcileHandler) handleReconcilerReturn(ctx context.Context, object client.Object, output *service.PolicyReconcileOutput, reconcileError error, setStatus func(output *service.PolicyReconcileOutput, reconcileError error)) (ctrl.Result, error) {
+
+	if errors.Is(reconcileError, typederror.UnrecoverableError) {
+		h.log.Error(reconcileError, "got unrecoverable error, giving up...")
+		recordReconcileError(h.recorder, object, reconcileError, true)
+		// report the error in the status conditions, the object is not reconciled again until its spec changes
+		setStatus(output, reconcileError)
+		if err := h.Status().Update(ctx, object); err != nil {
+			h.log.Error(err, "failed to update policy status")
+		}
+		return ctrl.Result{Requeue: false}, nil
+	}
+
+	if output.Deleted {
+		controllerutil.RemoveFinalizer(object, policyFinalizerName)
+		if err := h.Update(ctx, object); err != nil {
+			h.log.Error(err, "failed to remove Finalizer from policy")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	setStatus(output, reconcileError)
+
+	if reconcileError != nil {
+		h.log.Error(reconcileError, "failed to reconcile, trying to update last known status")
+		recordReconcileError(h.recorder, object, reconcileError, false)
+	}
+
+	err := h.Status().Update(ctx, object)
+	if err != nil {
+		h.log.Error(reconcileError, "failed to update policy status, retrying in 5 seconds")
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, err
+	}
+
+	if reconcileError != nil {
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, reconcileError
+	}
+
+	return ctrl.Result{Requeue: false}, nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package access
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/controllers/access/converter"
+	"bitbucket.org/accezz-io/sac-operator/service"
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
+	"bitbucket.org/accezz-io/sac-operator/utils/typederror"
+)
+
+func TestPolicyReconcileHandler_DeletedTenant(t *testing.T) {
+	tests := []struct {
+		name          string
+		tenants       []client.Object
+		expectedError bool
+	}{
+		{
+			name: "tenant deleted",
+		},
+		{
+			name:          "tenant not registered yet",
+			tenants:       []client.Object{&accessv1.SecureAccessCloudTenant{ObjectMeta: metav1.ObjectMeta{Name: "staging"}}},
+			expectedError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given an access policy being deleted, whose tenant is not registered
+			scheme := runtime.NewScheme()
+			require.NoError(t, accessv1.AddToScheme(scheme))
+			deletionTimestamp := metav1.Now()
+			policy := &accessv1.AccessPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-policy", Namespace: "apps", DeletionTimestamp: &deletionTimestamp, Finalizers: []string{policyFinalizerName}},
+				Spec:       accessv1.AccessPolicySpec{TenantRef: "staging"},
+				Status:     accessv1.CommonPolicyStatus{Id: "policy-uuid"},
+			}
+			recorder := record.NewFakeRecorder(10)
+			reconciler := &AccessPolicyReconciler{
+				Client:                   fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(tt.tenants, policy)...).Build(),
+				Scheme:                   scheme,
+				SecureAccessCloudClients: sac.NewSecureAccessCloudClientRegistry(),
+				Recorder:                 recorder,
+				ConverterToModel:         converter.NewAccessPolicyConverter(),
+				Log:                      logr.Discard(),
+			}
+
+			// when
+			_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "my-policy", Namespace: "apps"}})
+
+			// then
+			reconciled := &accessv1.AccessPolicy{}
+			getErr := reconciler.Get(context.Background(), types.NamespacedName{Name: "my-policy", Namespace: "apps"}, reconciled)
+			if tt.expectedError {
+				assert.ErrorIs(t, err, sac.ErrorTenantNotFound)
+				require.NoError(t, getErr)
+				assert.True(t, controllerutil.ContainsFinalizer(reconciled, policyFinalizerName))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "Warning TenantDeleted tenant staging was deleted, policy policy-uuid is left in Secure-Access-Cloud", <-recorder.Events)
+			// the finalizer was removed, so the policy is deleted
+			assert.True(t, apierrors.IsNotFound(getErr))
+		})
+	}
+}
+
+func TestPolicyReconcileHandler_handleReconcilerReturn_UnrecoverableError(t *testing.T) {
+	// given a policy whose reconcile failed with an unrecoverable error
+	scheme := runtime.NewScheme()
+	require.NoError(t, accessv1.AddToScheme(scheme))
+	policy := &accessv1.AccessPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-policy", Namespace: "apps", Generation: 2},
+		Status:     accessv1.CommonPolicyStatus{ObservedGeneration: 1},
+	}
+	recorder := record.NewFakeRecorder(10)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(policy).Build()
+	handler := newPolicyReconcileHandler(c, sac.NewSecureAccessCloudClientRegistry(), recorder, logr.Discard())
+	policyConverter := converter.NewAccessPolicyConverter()
+	reconcileError := fmt.Errorf("%w policy my-policy already exist", typederror.UnrecoverableError)
+
+	// when
+	result, err := handler.handleReconcilerReturn(context.Background(), policy, &service.PolicyReconcileOutput{}, reconcileError, func(output *service.PolicyReconcileOutput, reconcileError error) {
+		policy.Status = policyConverter.ConvertFromServiceOutput(policy.Status, policy.Generation, output, reconcileError)
+	})
+
+	// then the policy is not reconciled again and its status reports the error
+	assert.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result)
+	reconciled := &accessv1.AccessPolicy{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "my-policy", Namespace: "apps"}, reconciled))
+	assert.Equal(t, int64(1), reconciled.Status.ObservedGeneration)
+	ready := meta.FindStatusCondition(reconciled.Status.Conditions, accessv1.ConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, accessv1.ReasonUnrecoverableError, ready.Reason)
+	assert.Contains(t, ready.Message, "policy my-policy already exist")
+	assert.Len(t, recorder.Events, 1)
+}
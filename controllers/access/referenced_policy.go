@@ -0,0 +1,107 @@
+package access
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+)
+
+// accessPolicyRefKey and activityPolicyRefKey are the field indexes of the applications by the names of the
+// AccessPolicy and ActivityPolicy objects they reference, in their namespace.
+const (
+	accessPolicyRefKey   = ".spec.access_policies"
+	activityPolicyRefKey = ".spec.activity_policies"
+)
+
+// indexReferencedPolicies indexes the applications of the given kind by the policies they reference. commonParams
+// returns the common params of an application of the kind.
+func indexReferencedPolicies(mgr ctrl.Manager, application client.Object, commonParams func(application client.Object) accessv1.CommonApplicationParams) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), application, accessPolicyRefKey, func(rawObj client.Object) []string {
+		return commonParams(rawObj).AccessPoliciesNames
+	}); err != nil {
+		return err
+	}
+	return mgr.GetFieldIndexer().IndexField(context.Background(), application, activityPolicyRefKey, func(rawObj client.Object) []string {
+		return commonParams(rawObj).ActivityPoliciesNames
+	})
+}
+
+// watchReferencedPolicies watches the AccessPolicy and ActivityPolicy objects, and enqueues the applications of the
+// given list kind which reference a policy once it is created in Secure-Access-Cloud, or deleted. The applications
+// must be indexed with indexReferencedPolicies.
+func watchReferencedPolicies(b *builder.Builder, c client.Reader, applications client.ObjectList, log logr.Logger) *builder.Builder {
+	mapFunc := handler.EnqueueRequestsFromMapFunc(func(policy client.Object) []reconcile.Request {
+		return findApplicationsForPolicy(c, applications, policy, log)
+	})
+	return b.
+		Watches(&source.Kind{Type: &accessv1.AccessPolicy{}}, mapFunc, builder.WithPredicates(policyIDChangedPredicate())).
+		Watches(&source.Kind{Type: &accessv1.ActivityPolicy{}}, mapFunc, builder.WithPredicates(policyIDChangedPredicate()))
+}
+
+// findApplicationsForPolicy maps a policy to the applications of the given list kind referencing it.
+func findApplicationsForPolicy(c client.Reader, applications client.ObjectList, policy client.Object, log logr.Logger) []reconcile.Request {
+	key := accessPolicyRefKey
+	if _, ok := policy.(*accessv1.ActivityPolicy); ok {
+		key = activityPolicyRefKey
+	}
+
+	list := applications.DeepCopyObject().(client.ObjectList)
+	if err := c.List(context.Background(), list, client.InNamespace(policy.GetNamespace()), client.MatchingFields{key: policy.GetName()}); err != nil {
+		log.Error(err, "unable to list applications", "policy", policy.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	_ = meta.EachListItem(list, func(obj runtime.Object) error {
+		application := obj.(client.Object)
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: application.GetName(), Namespace: application.GetNamespace()}})
+		return nil
+	})
+	return requests
+}
+
+// policyIDChangedPredicate filters out the policy updates which do not change the SAC id of the policy, i.e. which do
+// not make it available to the applications.
+func policyIDChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return policyID(e.ObjectOld) != policyID(e.ObjectNew)
+		},
+	}
+}
+
+// policyKind returns the kind of an AccessPolicy or an ActivityPolicy object, whose type meta may be empty.
+func policyKind(policy client.Object) string {
+	switch policy.(type) {
+	case *accessv1.AccessPolicy:
+		return "AccessPolicy"
+	case *accessv1.ActivityPolicy:
+		return "ActivityPolicy"
+	}
+	return ""
+}
+
+// policyID returns the SAC id of an AccessPolicy or an ActivityPolicy, empty until it is created in
+// Secure-Access-Cloud.
+func policyID(policy client.Object) string {
+	switch p := policy.(type) {
+	case *accessv1.AccessPolicy:
+		return p.Status.Id
+	case *accessv1.ActivityPolicy:
+		return p.Status.Id
+	}
+	return ""
+}
@@ -0,0 +1,112 @@
+package access
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
+)
+
+func TestApplicationReconcileHandler_resolvePolicies(t *testing.T) {
+	tests := []struct {
+		name          string
+		policies      []client.Object
+		expectedIDs   map[model.PolicyReference]string
+		expectedError bool
+	}{
+		{
+			name: "access and activity policies of the same name",
+			policies: []client.Object{
+				&accessv1.AccessPolicy{ObjectMeta: metav1.ObjectMeta{Name: "devops", Namespace: "apps"}, Status: accessv1.CommonPolicyStatus{Id: "access-uuid"}},
+				&accessv1.ActivityPolicy{ObjectMeta: metav1.ObjectMeta{Name: "devops", Namespace: "apps"}, Status: accessv1.CommonPolicyStatus{Id: "activity-uuid"}},
+			},
+			expectedIDs: map[model.PolicyReference]string{
+				{Type: model.AccessPolicy, Name: "devops"}:   "access-uuid",
+				{Type: model.ActivityPolicy, Name: "devops"}: "activity-uuid",
+			},
+		},
+		{
+			name: "policy not managed in the cluster",
+			policies: []client.Object{
+				&accessv1.AccessPolicy{ObjectMeta: metav1.ObjectMeta{Name: "devops", Namespace: "apps"}, Status: accessv1.CommonPolicyStatus{Id: "access-uuid"}},
+			},
+			expectedIDs: map[model.PolicyReference]string{
+				{Type: model.AccessPolicy, Name: "devops"}: "access-uuid",
+			},
+		},
+		{
+			name: "policy not created in Secure-Access-Cloud yet",
+			policies: []client.Object{
+				&accessv1.AccessPolicy{ObjectMeta: metav1.ObjectMeta{Name: "devops", Namespace: "apps"}},
+			},
+			expectedError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			scheme := runtime.NewScheme()
+			require.NoError(t, accessv1.AddToScheme(scheme))
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.policies...).Build()
+			handler := newApplicationReconcileHandler(c, sac.NewSecureAccessCloudClientRegistry(), record.NewFakeRecorder(10), 0, logr.Discard())
+			application := &model.Application{CommonApplicationParams: model.CommonApplicationParams{
+				AccessPoliciesNames:   []string{"devops"},
+				ActivityPoliciesNames: []string{"devops"},
+			}}
+
+			// when
+			err := handler.resolvePolicies(context.Background(), "apps", application)
+
+			// then
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedIDs, application.ResolvedPoliciesIDs)
+		})
+	}
+}
+
+func TestPolicyIDChangedPredicate(t *testing.T) {
+	tests := []struct {
+		name      string
+		oldPolicy client.Object
+		newPolicy client.Object
+		expected  bool
+	}{
+		{
+			name:      "policy created in Secure-Access-Cloud",
+			oldPolicy: &accessv1.AccessPolicy{},
+			newPolicy: &accessv1.AccessPolicy{Status: accessv1.CommonPolicyStatus{Id: "policy-uuid"}},
+			expected:  true,
+		},
+		{
+			name:      "policy updated",
+			oldPolicy: &accessv1.ActivityPolicy{Status: accessv1.CommonPolicyStatus{Id: "policy-uuid"}},
+			newPolicy: &accessv1.ActivityPolicy{ObjectMeta: metav1.ObjectMeta{Generation: 2}, Status: accessv1.CommonPolicyStatus{Id: "policy-uuid"}},
+			expected:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			result := policyIDChangedPredicate().Update(event.UpdateEvent{ObjectOld: tt.oldPolicy, ObjectNew: tt.newPolicy})
+
+			// then
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+)
+
+// serviceRefKey is the field index of the applications by the namespace/name of the Service they expose
+const serviceRefKey = ".spec.service"
+
+// serviceRefKeyValue returns the namespace/name of the referenced service, the service namespace defaults to the
+// application namespace.
+func serviceRefKeyValue(service accessv1.Service, applicationNamespace string) string {
+	return serviceRefNamespacedName(service, applicationNamespace).String()
+}
+
+func serviceRefNamespacedName(service accessv1.Service, applicationNamespace string) types.NamespacedName {
+	namespace := applicationNamespace
+	if service.Namespace != "" {
+		namespace = service.Namespace
+	}
+	return types.NamespacedName{Name: service.Name, Namespace: namespace}
+}
+
+// resolveReferencedService resolves the port of the referenced Service which is exposed by the application. The port
+// of the spec is matched against the Service ports by name, then by number and finally by target port, so an
+// application may reference the container port behind the Service. When no port is resolved, the returned reason and
+// message describe why, an error is returned only when the Service could not be fetched.
+func resolveReferencedService(ctx context.Context, c client.Reader, service accessv1.Service, applicationNamespace string) (servicePort *corev1.ServicePort, reason, message string, err error) {
+
+	key := serviceRefNamespacedName(service, applicationNamespace)
+	k8sService := &corev1.Service{}
+	if err := c.Get(ctx, key, k8sService); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, accessv1.ReasonServiceNotFound, fmt.Sprintf("service %s was not found", key), nil
+		}
+		return nil, "", "", err
+	}
+
+	ports := k8sService.Spec.Ports
+	matchers := []func(port corev1.ServicePort) bool{
+		func(port corev1.ServicePort) bool { return port.Name == service.Port },
+		func(port corev1.ServicePort) bool { return strconv.Itoa(int(port.Port)) == service.Port },
+		func(port corev1.ServicePort) bool { return port.TargetPort.String() == service.Port },
+	}
+	for _, matches := range matchers {
+		for i := range ports {
+			if matches(ports[i]) {
+				return &ports[i], accessv1.ReasonServiceAvailable, "", nil
+			}
+		}
+	}
+	return nil, accessv1.ReasonServicePortNotFound, fmt.Sprintf("service %s has no port %s", key, service.Port), nil
+}
+
+// resolveReferencedServices resolves the ports of the given referenced Services, in the same order. When a port is not
+// resolved, the returned reason and message describe why for the first of them.
+func resolveReferencedServices(ctx context.Context, c client.Reader, services []accessv1.Service, applicationNamespace string) (servicePorts []*corev1.ServicePort, reason, message string, err error) {
+
+	reason = accessv1.ReasonServiceAvailable
+	for _, service := range services {
+		servicePort, serviceReason, serviceMessage, err := resolveReferencedService(ctx, c, service, applicationNamespace)
+		if err != nil {
+			return nil, "", "", err
+		}
+		if serviceReason != accessv1.ReasonServiceAvailable && reason == accessv1.ReasonServiceAvailable {
+			reason, message = serviceReason, serviceMessage
+		}
+		servicePorts = append(servicePorts, servicePort)
+	}
+	return servicePorts, reason, message, nil
+}
+
+// indexReferencedServices indexes the applications of the given kind by the Services they expose. services returns
+// the services exposed by an application of the kind.
+func indexReferencedServices(mgr ctrl.Manager, application client.Object, services func(application client.Object) []accessv1.Service) error {
+	return mgr.GetFieldIndexer().IndexField(context.Background(), application, serviceRefKey, func(rawObj client.Object) []string {
+		var keys []string
+		for _, service := range services(rawObj) {
+			keys = append(keys, serviceRefKeyValue(service, rawObj.GetNamespace()))
+		}
+		return keys
+	})
+}
+
+// watchReferencedServices watches the Services, and enqueues the applications of the given list kind which expose a
+// Service on any of its changes. The applications must be indexed with indexReferencedServices.
+func watchReferencedServices(b *builder.Builder, c client.Reader, applications client.ObjectList, log logr.Logger) *builder.Builder {
+	return b.Watches(&source.Kind{Type: &corev1.Service{}}, handler.EnqueueRequestsFromMapFunc(func(k8sService client.Object) []reconcile.Request {
+		return findApplicationsForService(c, applications, k8sService, log)
+	}))
+}
+
+// findApplicationsForService maps a Service to the applications of the given list kind exposing it.
+func findApplicationsForService(c client.Reader, applications client.ObjectList, k8sService client.Object, log logr.Logger) []reconcile.Request {
+	key := types.NamespacedName{Name: k8sService.GetName(), Namespace: k8sService.GetNamespace()}.String()
+	list := applications.DeepCopyObject().(client.ObjectList)
+	if err := c.List(context.Background(), list, client.MatchingFields{serviceRefKey: key}); err != nil {
+		log.Error(err, "unable to list applications", "service", key)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	_ = meta.EachListItem(list, func(obj runtime.Object) error {
+		application := obj.(client.Object)
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: application.GetName(), Namespace: application.GetNamespace()}})
+		return nil
+	})
+	return requests
+}
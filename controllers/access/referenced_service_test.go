@@ -0,0 +1,105 @@
+package access
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+)
+
+func TestResolveReferencedService(t *testing.T) {
+	k8sService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-service", Namespace: "apps"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "web", Port: 80, TargetPort: intstr.FromString("http")},
+				{Name: "admin", Port: 8443, TargetPort: intstr.FromInt(9443)},
+			},
+		},
+	}
+	tests := []struct {
+		name           string
+		service        accessv1.Service
+		expectedPort   string
+		expectedReason string
+	}{
+		{
+			name:           "port number",
+			service:        accessv1.Service{Name: "my-service", Port: "8443"},
+			expectedPort:   "admin",
+			expectedReason: accessv1.ReasonServiceAvailable,
+		},
+		{
+			name:           "port name",
+			service:        accessv1.Service{Name: "my-service", Port: "web"},
+			expectedPort:   "web",
+			expectedReason: accessv1.ReasonServiceAvailable,
+		},
+		{
+			name:           "target port number",
+			service:        accessv1.Service{Name: "my-service", Port: "9443"},
+			expectedPort:   "admin",
+			expectedReason: accessv1.ReasonServiceAvailable,
+		},
+		{
+			name:           "target port name",
+			service:        accessv1.Service{Name: "my-service", Port: "http"},
+			expectedPort:   "web",
+			expectedReason: accessv1.ReasonServiceAvailable,
+		},
+		{
+			name:           "port not found",
+			service:        accessv1.Service{Name: "my-service", Port: "9090"},
+			expectedReason: accessv1.ReasonServicePortNotFound,
+		},
+		{
+			name:           "service in another namespace",
+			service:        accessv1.Service{Name: "my-service", Namespace: "other-apps", Port: "80"},
+			expectedReason: accessv1.ReasonServiceNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(k8sService).Build()
+			servicePort, reason, _, err := resolveReferencedService(context.Background(), c, tt.service, "apps")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedReason, reason)
+			if tt.expectedPort == "" {
+				assert.Nil(t, servicePort)
+			} else {
+				require.NotNil(t, servicePort)
+				assert.Equal(t, tt.expectedPort, servicePort.Name)
+			}
+		})
+	}
+}
+
+func TestResolveReferencedServices(t *testing.T) {
+	// given the services of a multiple machines application, the second one not existing
+	k8sService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "windows-vm-1", Namespace: "vms"},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "rdp", Port: 3389}}},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(k8sService).Build()
+	services := []accessv1.Service{{Name: "windows-vm-1", Port: "rdp"}, {Name: "windows-vm-2", Port: "rdp"}}
+
+	// when
+	servicePorts, reason, message, err := resolveReferencedServices(context.Background(), c, services, "vms")
+
+	// then the ports are resolved in order, and the first unresolved one is reported
+	require.NoError(t, err)
+	require.Len(t, servicePorts, 2)
+	require.NotNil(t, servicePorts[0])
+	assert.Equal(t, int32(3389), servicePorts[0].Port)
+	assert.Nil(t, servicePorts[1])
+	assert.Equal(t, accessv1.ReasonServiceNotFound, reason)
+	assert.Equal(t, "service vms/windows-vm-2 was not found", message)
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package access
+
+import (
+	"context"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
+
+	"bitbucket.org/accezz-io/sac-operator/controllers/access/converter"
+
+	"github.com/go-logr/logr"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// credentialsSecretRefKey is the field index of the tenants by the namespace/name of their credentials secret
+const credentialsSecretRefKey = ".spec.credentialsSecretRef"
+
+// tenantValidationInterval is the interval in which the tenant credentials are validated again
+var tenantValidationInterval = 10 * time.Minute
+
+// SecureAccessCloudTenantReconciler reconciles a SecureAccessCloudTenant object
+type SecureAccessCloudTenantReconciler struct {
+	client.Client
+	Scheme                   *runtime.Scheme
+	SecureAccessCloudClients *sac.SecureAccessCloudClientRegistry
+	Converter                *converter.SecureAccessCloudTenantConverter
+	Log                      logr.Logger
+}
+
+//+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=secureaccesscloudtenants,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=secureaccesscloudtenants/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=secureaccesscloudtenants/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+// Reconcile reads the credentials secret of the SecureAccessCloudTenant, registers the tenant SecureAccessCloudClient
+// to be used by the objects referencing the tenant and reports whether the credentials are valid. The credentials
+// secret is watched, so rotated credentials replace the tenant client (and its cached access token) without
+// restarting the operator.
+func (r *SecureAccessCloudTenantReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	tenant := &accessv1.SecureAccessCloudTenant{}
+
+	if err := r.Get(ctx, req.NamespacedName, tenant); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.SecureAccessCloudClients.Unregister(req.Name)
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "unable to fetch tenant")
+		return ctrl.Result{}, err
+	}
+
+	log := r.Log.WithValues("tenant", tenant.Name)
+
+	secret := &corev1.Secret{}
+	secretKey := types.NamespacedName{Name: tenant.Spec.CredentialsSecretRef.Name, Namespace: tenant.Spec.CredentialsSecretRef.Namespace}
+	if err := r.Get(ctx, secretKey, secret); err != nil {
+		log.Error(err, "unable to fetch credentials secret")
+		return r.updateStatus(ctx, tenant, "", err)
+	}
+
+	settings, err := r.Converter.ConvertToSettings(secret)
+	if err != nil {
+		log.Error(err, "invalid credentials secret")
+		return r.updateStatus(ctx, tenant, "", err)
+	}
+
+	r.SecureAccessCloudClients.Register(tenant.Name, *settings)
+
+	err = settings.ValidateCredentials(ctx)
+	if err != nil {
+		log.Error(err, "invalid tenant credentials")
+	}
+	return r.updateStatus(ctx, tenant, settings.TenantDomain, err)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SecureAccessCloudTenantReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &accessv1.SecureAccessCloudTenant{}, credentialsSecretRefKey, func(rawObj client.Object) []string {
+		ref := rawObj.(*accessv1.SecureAccessCloudTenant).Spec.CredentialsSecretRef
+		return []string{types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}.String()}
+	}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&accessv1.SecureAccessCloudTenant{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(r.findTenantsForSecret)).
+		Complete(r)
+}
+
+// findTenantsForSecret maps a credentials secret to the tenants referencing it.
+func (r *SecureAccessCloudTenantReconciler) findTenantsForSecret(secret client.Object) []reconcile.Request {
+	tenants := &accessv1.SecureAccessCloudTenantList{}
+	key := types.NamespacedName{Name: secret.GetName(), Namespace: secret.GetNamespace()}.String()
+	if err := r.List(context.Background(), tenants, client.MatchingFields{credentialsSecretRefKey: key}); err != nil {
+		r.Log.Error(err, "unable to list tenants", "secret", key)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(tenants.Items))
+	for _, tenant := range tenants.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: tenant.Name}})
+	}
+	return requests
+}
+
+func (r *SecureAccessCloudTenantReconciler) updateStatus(ctx context.Context, tenant *accessv1.SecureAccessCloudTenant, tenantDomain string, validationError error) (ctrl.Result, error) {
+
+	tenant.Status = accessv1.SecureAccessCloudTenantStatus{
+		TenantDomain:          tenantDomain,
+		CredentialsValid:      validationError == nil,
+		LastValidated:         metav1.Now(),
+		CredentialsGeneration: r.SecureAccessCloudClients.CredentialsGeneration(tenant.Name),
+	}
+	if validationError != nil {
+		tenant.Status.Message = validationError.Error()
+	}
+
+	if err := r.Status().Update(ctx, tenant); err != nil {
+		r.Log.WithValues("tenant", tenant.Name).Error(err, "failed to update tenant status, retrying in 5 seconds")
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, err
+	}
+
+	return ctrl.Result{RequeueAfter: tenantValidationInterval}, nil
+}
+
+// tenantDeleted returns whether the SecureAccessCloudTenant of the given name was deleted, in which case the objects
+// of the tenant cannot be deleted from Secure-Access-Cloud anymore and their finalizer is removed rather than blocking
+// their deletion forever. A tenant which exists but is not registered yet, or the default tenant, is not deleted.
+func tenantDeleted(ctx context.Context, reader client.Reader, tenantName string) (bool, error) {
+	if tenantName == sac.DefaultTenant {
+		return false, nil
+	}
+
+	tenant := &accessv1.SecureAccessCloudTenant{}
+	if err := reader.Get(ctx, types.NamespacedName{Name: tenantName}, tenant); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
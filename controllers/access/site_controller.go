@@ -45,6 +45,7 @@ import (
 
 	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -52,6 +53,7 @@ import (
 var (
 	siteFinalizerName        = "sites.access.secure-access-cloud.symantec.com/finalizer"
 	applicationFinalizerName = "application.access.secure-access-cloud.symantec.com/finalizer"
+	policyFinalizerName      = "policy.access.secure-access-cloud.symantec.com/finalizer"
 	podOwnerKey              = ".metadata.controller"
 	apiGVStr                 = accessv1.GroupVersion.String()
 )
@@ -59,10 +61,12 @@ var (
 // SiteReconcile reconciles a SiteName object
 type SiteReconcile struct {
 	client.Client
-	Scheme                  *runtime.Scheme
-	SiteConverter           *converter.SiteConverter
-	SecureAccessCloudClient sac.SecureAccessCloudClient
-	Log                     logr.Logger
+	Scheme                   *runtime.Scheme
+	SiteConverter            *converter.SiteConverter
+	SecureAccessCloudClients *sac.SecureAccessCloudClientRegistry
+	Recorder                 record.EventRecorder
+	ResyncInterval           time.Duration
+	Log                      logr.Logger
 }
 
 //+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=sites,verbs=get;list;watch;create;update;patch;delete
@@ -70,6 +74,7 @@ type SiteReconcile struct {
 //+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=sites/finalizers,verbs=update
 //+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=pods/status,verbs=get
+//+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -90,8 +95,23 @@ func (r *SiteReconcile) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 	}
 
 	model := r.SiteConverter.ConvertToServiceModel(site)
-	serviceImpl := r.serviceFactory(model)
-	output, err := serviceImpl.Reconcile(ctx, model)
+	serviceImpl, err := r.serviceFactory(model)
+	if err != nil && model.ToDelete && errors.Is(err, sac.ErrorTenantNotFound) {
+		deleted, tenantErr := tenantDeleted(ctx, r.Client, model.TenantName)
+		if tenantErr != nil {
+			err = tenantErr
+		} else if deleted {
+			r.Recorder.Eventf(site, corev1.EventTypeWarning, eventReasonTenantDeleted,
+				"tenant %s was deleted, site %s is left in Secure-Access-Cloud", model.TenantName, model.SACSiteID)
+			return r.handleReconcilerReturn(ctx, site, &service.SiteReconcileOutput{SACSiteID: model.SACSiteID, Deleted: true}, nil)
+		}
+	}
+	if err != nil {
+		r.Log.WithValues("site", site.Name).Error(err, "unable to get the site tenant client, retrying in 5 seconds")
+		recordReconcileError(r.Recorder, site, err, false)
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, err
+	}
+	output, err := serviceImpl.SetEventRecorder(newObjectEventRecorder(r.Recorder, site)).Reconcile(ctx, model)
 	if !controllerutil.ContainsFinalizer(site, siteFinalizerName) && output.SACSiteID != "" {
 		controllerutil.AddFinalizer(site, siteFinalizerName)
 		if err := r.Update(ctx, site); err != nil {
@@ -130,20 +150,29 @@ func (r *SiteReconcile) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
-func (r *SiteReconcile) serviceFactory(site *model.Site) *service.SiteServiceImpl {
+func (r *SiteReconcile) serviceFactory(site *model.Site) (*service.SiteServiceImpl, error) {
 	log := r.Log.WithValues("site", site.Name)
-	sacClient := r.SecureAccessCloudClient
+	sacClient, err := r.SecureAccessCloudClients.Get(site.TenantName)
+	if err != nil {
+		return nil, err
+	}
 	k8sClients := connector_deployer.NewKubernetesImpl(r.Client, r.Scheme, podOwnerKey, log).
 		SetConnectorImagePullSecret(site.ConnectorConfiguration.ImagePullSecrets).
 		SetSiteNamespace(site.SiteNamespace)
 
-	return service.NewSiteServiceImpl(sacClient, k8sClients, log)
+	return service.NewSiteServiceImpl(sacClient, k8sClients, log), nil
 }
 
 func (r *SiteReconcile) handleReconcilerReturn(ctx context.Context, siteCRD *accessv1.Site, output *service.SiteReconcileOutput, reconcileError error) (ctrl.Result, error) {
 
 	if errors.Is(reconcileError, typederror.UnrecoverableError) {
 		r.Log.WithValues("site", siteCRD.Name).Error(reconcileError, "got unrecoverable error, giving up...")
+		recordReconcileError(r.Recorder, siteCRD, reconcileError, true)
+		// report the error in the status conditions, the site is not reconciled again until its spec changes
+		siteCRD.Status = r.SiteConverter.ConvertFromServiceOutput(siteCRD, output, reconcileError)
+		if err := r.Status().Update(ctx, siteCRD); err != nil {
+			r.Log.WithValues("site", siteCRD.Name).Error(err, "failed to update site status")
+		}
 		return ctrl.Result{}, nil
 	}
 
@@ -156,10 +185,11 @@ func (r *SiteReconcile) handleReconcilerReturn(ctx context.Context, siteCRD *acc
 		return ctrl.Result{}, nil
 	}
 
-	siteCRD.Status = r.SiteConverter.ConvertFromServiceOutput(output)
+	siteCRD.Status = r.SiteConverter.ConvertFromServiceOutput(siteCRD, output, reconcileError)
 
 	if reconcileError != nil {
 		r.Log.WithValues("site", siteCRD.Name).Error(reconcileError, "failed to reconcile, trying to update last known status")
+		recordReconcileError(r.Recorder, siteCRD, reconcileError, false)
 	}
 	err := r.Status().Update(ctx, siteCRD)
 	if err != nil {
@@ -171,6 +201,6 @@ func (r *SiteReconcile) handleReconcilerReturn(ctx context.Context, siteCRD *acc
 		return ctrl.Result{RequeueAfter: 5 * time.Second}, reconcileError
 	}
 
-	return ctrl.Result{Requeue: false}, nil
+	return ctrl.Result{RequeueAfter: r.ResyncInterval}, nil
 
 }
@@ -0,0 +1,95 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package access
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/controllers/access/converter"
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
+)
+
+func TestSiteReconcile_Reconcile_DeletedTenant(t *testing.T) {
+	tests := []struct {
+		name          string
+		tenants       []client.Object
+		expectedError bool
+	}{
+		{
+			name: "tenant deleted",
+		},
+		{
+			name:          "tenant not registered yet",
+			tenants:       []client.Object{&accessv1.SecureAccessCloudTenant{ObjectMeta: metav1.ObjectMeta{Name: "staging"}}},
+			expectedError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given a site being deleted, whose tenant is not registered
+			scheme := runtime.NewScheme()
+			require.NoError(t, accessv1.AddToScheme(scheme))
+			deletionTimestamp := metav1.Now()
+			site := &accessv1.Site{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-site", Namespace: "sites", DeletionTimestamp: &deletionTimestamp, Finalizers: []string{siteFinalizerName}},
+				Spec:       accessv1.SiteSpec{NumberOfConnectors: 1, TenantRef: "staging"},
+				Status:     accessv1.SiteStatus{ID: "site-uuid"},
+			}
+			recorder := record.NewFakeRecorder(10)
+			reconciler := &SiteReconcile{
+				Client:                   fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(tt.tenants, site)...).Build(),
+				Scheme:                   scheme,
+				SiteConverter:            converter.NewSiteConverter(),
+				SecureAccessCloudClients: sac.NewSecureAccessCloudClientRegistry(),
+				Recorder:                 recorder,
+				Log:                      logr.Discard(),
+			}
+
+			// when
+			_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "my-site", Namespace: "sites"}})
+
+			// then
+			reconciled := &accessv1.Site{}
+			getErr := reconciler.Get(context.Background(), types.NamespacedName{Name: "my-site", Namespace: "sites"}, reconciled)
+			if tt.expectedError {
+				assert.ErrorIs(t, err, sac.ErrorTenantNotFound)
+				require.NoError(t, getErr)
+				assert.True(t, controllerutil.ContainsFinalizer(reconciled, siteFinalizerName))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "Warning TenantDeleted tenant staging was deleted, site site-uuid is left in Secure-Access-Cloud", <-recorder.Events)
+			// the finalizer was removed, so the site is deleted
+			assert.True(t, apierrors.IsNotFound(getErr))
+		})
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package access
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-logr/logr"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// MinConnectorsAnnotation overrides, for the Sites of the annotated Namespace, the minimum number of connectors.
+	MinConnectorsAnnotation = "access.secure-access-cloud.symantec.com/min-connectors"
+	// MaxConnectorsAnnotation overrides, for the Sites of the annotated Namespace, the maximum number of connectors.
+	MaxConnectorsAnnotation = "access.secure-access-cloud.symantec.com/max-connectors"
+)
+
+// SiteWebhook defaults and validates the Sites at apply time, so an invalid spec never reaches the site service.
+type SiteWebhook struct {
+	client.Client
+	// the bounds of number_of_connectors, unless overridden by the annotations of the site namespace
+	MinConnectors int
+	MaxConnectors int
+	Log           logr.Logger
+}
+
+//+kubebuilder:webhook:path=/mutate-access-secure-access-cloud-symantec-com-v1-site,mutating=true,failurePolicy=fail,sideEffects=None,groups=access.secure-access-cloud.symantec.com,resources=sites,verbs=create;update,versions=v1,name=msite.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-access-secure-access-cloud-symantec-com-v1-site,mutating=false,failurePolicy=fail,sideEffects=None,groups=access.secure-access-cloud.symantec.com,resources=sites,verbs=create;update,versions=v1,name=vsite.kb.io,admissionReviewVersions=v1
+//+kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+
+// SetupWebhookWithManager registers the defaulting and the validating webhooks with the Manager.
+func (w *SiteWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&accessv1.Site{}).
+		WithDefaulter(w).
+		WithValidator(w).
+		Complete()
+}
+
+// Default sets the drift policy, and sets the number of connectors to the minimum of the site namespace when it is
+// not set.
+func (w *SiteWebhook) Default(ctx context.Context, obj runtime.Object) error {
+	site := obj.(*accessv1.Site)
+
+	if site.Spec.DriftPolicy == "" {
+		site.Spec.DriftPolicy = model.DriftPolicyCorrect
+	}
+	if site.Spec.NumberOfConnectors == 0 {
+		minConnectors, _, err := w.connectorsBounds(ctx, site.Namespace)
+		if err != nil {
+			return err
+		}
+		site.Spec.NumberOfConnectors = minConnectors
+	}
+	return nil
+}
+
+// ValidateCreate validates the fields of a new site and that no other site of the tenant has the same name.
+func (w *SiteWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	site := obj.(*accessv1.Site)
+	return w.validate(ctx, site)
+}
+
+// ValidateUpdate validates the fields of an updated site. The tenant cannot be changed, as the site created in
+// Secure-Access-Cloud would be left behind in the previous tenant.
+func (w *SiteWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) error {
+	oldSite, site := oldObj.(*accessv1.Site), newObj.(*accessv1.Site)
+
+	if !site.DeletionTimestamp.IsZero() {
+		// let the finalizer be removed from a site which is being deleted
+		return nil
+	}
+
+	if oldSite.Spec.TenantRef != site.Spec.TenantRef {
+		return w.reject(site, fmt.Errorf("tenant cannot be changed from %q to %q", oldSite.Spec.TenantRef, site.Spec.TenantRef))
+	}
+
+	return w.validate(ctx, site)
+}
+
+// ValidateDelete allows any site to be deleted.
+func (w *SiteWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+func (w *SiteWebhook) validate(ctx context.Context, site *accessv1.Site) error {
+
+	minConnectors, maxConnectors, err := w.connectorsBounds(ctx, site.Namespace)
+	if err != nil {
+		return err
+	}
+	if site.Spec.NumberOfConnectors < minConnectors || site.Spec.NumberOfConnectors > maxConnectors {
+		return w.reject(site, fmt.Errorf("number_of_connectors must be between %d and %d in namespace %s", minConnectors, maxConnectors, site.Namespace))
+	}
+
+	if site.Spec.ImagePullSecret != "" {
+		secret := &corev1.Secret{}
+		err := w.Get(ctx, types.NamespacedName{Namespace: site.Namespace, Name: site.Spec.ImagePullSecret}, secret)
+		if apierrors.IsNotFound(err) {
+			return w.reject(site, fmt.Errorf("image_pull_secret %s does not exist in namespace %s", site.Spec.ImagePullSecret, site.Namespace))
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := w.validateUniqueName(ctx, site); err != nil {
+		return w.reject(site, err)
+	}
+	return nil
+}
+
+// validateUniqueName validates that no site of the same tenant has the same name in another namespace. The site is
+// identified by its name in Secure-Access-Cloud, deleting one of the two sites would delete the site of the other.
+func (w *SiteWebhook) validateUniqueName(ctx context.Context, site *accessv1.Site) error {
+
+	sites := &accessv1.SiteList{}
+	if err := w.List(ctx, sites); err != nil {
+		return err
+	}
+	for i := range sites.Items {
+		other := &sites.Items[i]
+		if other.Name == site.Name && other.Namespace != site.Namespace && other.Spec.TenantRef == site.Spec.TenantRef {
+			return fmt.Errorf("site %s already exists in namespace %s", site.Name, other.Namespace)
+		}
+	}
+	return nil
+}
+
+// connectorsBounds returns the bounds of the number of connectors of the sites in the given namespace, which are the
+// webhook bounds unless overridden by the namespace annotations.
+func (w *SiteWebhook) connectorsBounds(ctx context.Context, namespace string) (minConnectors, maxConnectors int, err error) {
+
+	minConnectors, maxConnectors = w.MinConnectors, w.MaxConnectors
+
+	ns := &corev1.Namespace{}
+	if err := w.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return minConnectors, maxConnectors, nil
+		}
+		return 0, 0, err
+	}
+	if value, ok := ns.Annotations[MinConnectorsAnnotation]; ok {
+		if minConnectors, err = strconv.Atoi(value); err != nil {
+			return 0, 0, fmt.Errorf("invalid %s annotation of namespace %s: %w", MinConnectorsAnnotation, namespace, err)
+		}
+	}
+	if value, ok := ns.Annotations[MaxConnectorsAnnotation]; ok {
+		if maxConnectors, err = strconv.Atoi(value); err != nil {
+			return 0, 0, fmt.Errorf("invalid %s annotation of namespace %s: %w", MaxConnectorsAnnotation, namespace, err)
+		}
+	}
+	return minConnectors, maxConnectors, nil
+}
+
+func (w *SiteWebhook) reject(site *accessv1.Site, err error) error {
+	w.Log.Info("rejecting site", "namespace", site.Namespace, "name", site.Name, "reason", err.Error())
+	return apierrors.NewForbidden(accessv1.GroupVersion.WithResource("sites").GroupResource(), site.Name, err)
+}
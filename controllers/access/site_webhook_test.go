@@ -0,0 +1,154 @@
+package access
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/model"
+)
+
+func webhookTestSite(namespace string, numberOfConnectors int, imagePullSecret string) *accessv1.Site {
+	return &accessv1.Site{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-site", Namespace: namespace},
+		Spec:       accessv1.SiteSpec{NumberOfConnectors: numberOfConnectors, ImagePullSecret: imagePullSecret},
+	}
+}
+
+func newTestSiteWebhook(t *testing.T, objects ...client.Object) *SiteWebhook {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, accessv1.AddToScheme(scheme))
+	objects = append(objects,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "apps"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "large", Annotations: map[string]string{
+			MinConnectorsAnnotation: "2",
+			MaxConnectorsAnnotation: "50",
+		}}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "dockerhub", Namespace: "apps"}},
+	)
+	return &SiteWebhook{
+		Client:        fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+		MinConnectors: 1,
+		MaxConnectors: 10,
+		Log:           logr.Discard(),
+	}
+}
+
+func TestSiteWebhook_Default(t *testing.T) {
+	tests := []struct {
+		name                       string
+		site                       *accessv1.Site
+		expectedNumberOfConnectors int
+	}{
+		{
+			name:                       "number of connectors set",
+			site:                       webhookTestSite("apps", 3, ""),
+			expectedNumberOfConnectors: 3,
+		},
+		{
+			name:                       "number of connectors not set",
+			site:                       webhookTestSite("apps", 0, ""),
+			expectedNumberOfConnectors: 1,
+		},
+		{
+			name:                       "number of connectors not set in annotated namespace",
+			site:                       webhookTestSite("large", 0, ""),
+			expectedNumberOfConnectors: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			err := newTestSiteWebhook(t).Default(context.Background(), tt.site)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedNumberOfConnectors, tt.site.Spec.NumberOfConnectors)
+			assert.Equal(t, model.DriftPolicyCorrect, tt.site.Spec.DriftPolicy)
+		})
+	}
+}
+
+func TestSiteWebhook_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name          string
+		site          *accessv1.Site
+		tenant        string
+		expectedError string
+	}{
+		{
+			name: "valid site",
+			site: webhookTestSite("apps", 3, "dockerhub"),
+		},
+		{
+			name:          "negative number of connectors",
+			site:          webhookTestSite("apps", -1, ""),
+			expectedError: "number_of_connectors must be between 1 and 10 in namespace apps",
+		},
+		{
+			name:          "too many connectors",
+			site:          webhookTestSite("apps", 20, ""),
+			expectedError: "number_of_connectors must be between 1 and 10 in namespace apps",
+		},
+		{
+			name:   "many connectors in annotated namespace",
+			site:   webhookTestSite("large", 20, ""),
+			tenant: "staging",
+		},
+		{
+			name:          "missing image pull secret",
+			site:          webhookTestSite("apps", 3, "quay"),
+			expectedError: "image_pull_secret quay does not exist in namespace apps",
+		},
+		{
+			name:          "site name used in another namespace",
+			site:          webhookTestSite("other", 3, ""),
+			expectedError: "site my-site already exists in namespace apps",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			webhook := newTestSiteWebhook(t, webhookTestSite("apps", 3, ""))
+			tt.site.Spec.TenantRef = tt.tenant
+
+			// when
+			err := webhook.ValidateCreate(context.Background(), tt.site)
+
+			// then
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, apierrors.IsForbidden(err))
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestSiteWebhook_ValidateUpdate(t *testing.T) {
+	// given
+	oldSite := webhookTestSite("apps", 3, "")
+	site := oldSite.DeepCopy()
+	site.Spec.TenantRef = "staging"
+	webhook := newTestSiteWebhook(t, oldSite.DeepCopy())
+
+	// when
+	err := webhook.ValidateUpdate(context.Background(), oldSite, site)
+
+	// then
+	assert.True(t, apierrors.IsForbidden(err))
+	assert.Contains(t, err.Error(), `tenant cannot be changed from "" to "staging"`)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package access
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/accezz-io/sac-operator/model"
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
+
+	"bitbucket.org/accezz-io/sac-operator/controllers/access/converter"
+
+	"github.com/go-logr/logr"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SshApplicationReconciler reconciles a SshApplication object
+type SshApplicationReconciler struct {
+	client.Client
+	Scheme                   *runtime.Scheme
+	SecureAccessCloudClients *sac.SecureAccessCloudClientRegistry
+	Recorder                 record.EventRecorder
+	ResyncInterval           time.Duration
+	ConverterToModel         *converter.SshApplicationTypeConverter
+	Log                      logr.Logger
+}
+
+//+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=sshapplications,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=sshapplications/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=sshapplications/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch
+
+// Reconcile validates the SshApplication, converts it into an SSH application model and reconciles it in
+// Secure-Access-Cloud using the ApplicationService.
+func (r *SshApplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	application := &accessv1.SshApplication{}
+
+	if err := r.Get(ctx, req.NamespacedName, application); err != nil {
+		r.Log.Error(err, "unable to fetch application")
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	handler := newApplicationReconcileHandler(r.Client, r.SecureAccessCloudClients, r.Recorder, r.ResyncInterval, r.Log.WithValues("application", application.Name))
+	return handler.reconcileApplication(ctx, application, &application.Status, []accessv1.Service{application.Spec.Service},
+		func() error {
+			return r.ConverterToModel.Validate(application)
+		},
+		func(servicePorts []*corev1.ServicePort) (*model.Application, error) {
+			return r.ConverterToModel.ConvertToModel(application, servicePorts[0])
+		})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SshApplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return setupApplicationController(mgr, r, &accessv1.SshApplication{}, &accessv1.SshApplicationList{},
+		func(rawObj client.Object) accessv1.CommonApplicationParams {
+			return rawObj.(*accessv1.SshApplication).Spec.CommonApplicationParams
+		},
+		func(rawObj client.Object) []accessv1.Service {
+			return []accessv1.Service{rawObj.(*accessv1.SshApplication).Spec.Service}
+		}, r.Log)
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package access
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	"bitbucket.org/accezz-io/sac-operator/controllers/access/converter"
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
+)
+
+func TestSshApplicationReconciler_Reconcile_InvalidSpec(t *testing.T) {
+	// given an application without service port
+	scheme := runtime.NewScheme()
+	require.NoError(t, accessv1.AddToScheme(scheme))
+	application := &accessv1.SshApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "ssh", Namespace: "apps", Generation: 2},
+		Spec: accessv1.SshApplicationSpec{
+			CommonApplicationParams: accessv1.CommonApplicationParams{SiteName: "my-site"},
+			Service:                 accessv1.Service{Name: "ssh"},
+		},
+	}
+	recorder := record.NewFakeRecorder(10)
+	reconciler := &SshApplicationReconciler{
+		Client:                   fake.NewClientBuilder().WithScheme(scheme).WithObjects(application).Build(),
+		SecureAccessCloudClients: sac.NewSecureAccessCloudClientRegistry(),
+		Recorder:                 recorder,
+		ConverterToModel:         converter.NewSshApplicationTypeConverter(),
+		Log:                      logr.Discard(),
+	}
+
+	// when
+	result, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "ssh", Namespace: "apps"}})
+
+	// then the application is not reconciled again and its status reports the invalid spec
+	assert.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result)
+	reconciled := &accessv1.SshApplication{}
+	require.NoError(t, reconciler.Get(context.Background(), types.NamespacedName{Name: "ssh", Namespace: "apps"}, reconciled))
+	ready := meta.FindStatusCondition(reconciled.Status.Conditions, accessv1.ConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, accessv1.ReasonUnrecoverableError, ready.Reason)
+	assert.Contains(t, ready.Message, "service port cannot be empty")
+	assert.Len(t, recorder.Events, 1)
+}
+
+func TestSshApplicationReconciler_Reconcile_DeletedTenant(t *testing.T) {
+	tests := []struct {
+		name          string
+		tenants       []client.Object
+		expectedError bool
+	}{
+		{
+			name: "tenant deleted",
+		},
+		{
+			name:          "tenant not registered yet",
+			tenants:       []client.Object{&accessv1.SecureAccessCloudTenant{ObjectMeta: metav1.ObjectMeta{Name: "staging"}}},
+			expectedError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given an application being deleted, whose tenant is not registered
+			scheme := runtime.NewScheme()
+			require.NoError(t, accessv1.AddToScheme(scheme))
+			require.NoError(t, corev1.AddToScheme(scheme))
+			deletionTimestamp := metav1.Now()
+			application := &accessv1.SshApplication{
+				ObjectMeta: metav1.ObjectMeta{Name: "ssh", Namespace: "apps", DeletionTimestamp: &deletionTimestamp, Finalizers: []string{applicationFinalizerName}},
+				Spec: accessv1.SshApplicationSpec{
+					CommonApplicationParams: accessv1.CommonApplicationParams{SiteName: "my-site", TenantRef: "staging"},
+					Service:                 accessv1.Service{Name: "ssh", Port: "22"},
+				},
+				Status: accessv1.CommonApplicationStatus{Id: "app-uuid"},
+			}
+			reconciler := &SshApplicationReconciler{
+				Client:                   fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(tt.tenants, application)...).Build(),
+				SecureAccessCloudClients: sac.NewSecureAccessCloudClientRegistry(),
+				Recorder:                 record.NewFakeRecorder(10),
+				ConverterToModel:         converter.NewSshApplicationTypeConverter(),
+				Log:                      logr.Discard(),
+			}
+
+			// when
+			_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "ssh", Namespace: "apps"}})
+
+			// then
+			reconciled := &accessv1.SshApplication{}
+			getErr := reconciler.Get(context.Background(), types.NamespacedName{Name: "ssh", Namespace: "apps"}, reconciled)
+			if tt.expectedError {
+				assert.ErrorIs(t, err, sac.ErrorTenantNotFound)
+				require.NoError(t, getErr)
+				assert.True(t, controllerutil.ContainsFinalizer(reconciled, applicationFinalizerName))
+				return
+			}
+			assert.NoError(t, err)
+			// the finalizer was removed, so the application is deleted
+			assert.True(t, apierrors.IsNotFound(getErr))
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	accessv2 "bitbucket.org/accezz-io/sac-operator/apis/access/v2"
 	//+kubebuilder:scaffold:imports
 )
 
@@ -65,6 +66,9 @@ var _ = BeforeSuite(func() {
 	err = accessv1.AddToScheme(scheme.Scheme)
 	Expect(err).NotTo(HaveOccurred())
 
+	err = accessv2.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+
 	//+kubebuilder:scaffold:scheme
 
 	k8sClient, err = client.New(cfg, client.Options{Scheme: scheme.Scheme})
@@ -0,0 +1,81 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package access
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/accezz-io/sac-operator/model"
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
+
+	"bitbucket.org/accezz-io/sac-operator/controllers/access/converter"
+
+	"github.com/go-logr/logr"
+
+	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TcpApplicationReconciler reconciles a TcpApplication object
+type TcpApplicationReconciler struct {
+	client.Client
+	Scheme                   *runtime.Scheme
+	SecureAccessCloudClients *sac.SecureAccessCloudClientRegistry
+	Recorder                 record.EventRecorder
+	ResyncInterval           time.Duration
+	ConverterToModel         *converter.TcpApplicationTypeConverter
+	Log                      logr.Logger
+}
+
+//+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=tcpapplications,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=tcpapplications/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=access.secure-access-cloud.symantec.com,resources=tcpapplications/finalizers,verbs=update
+
+// Reconcile validates the targets of the TcpApplication, converts it into a TCP application
+// model and reconciles it in Secure-Access-Cloud using the ApplicationService.
+func (r *TcpApplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	application := &accessv1.TcpApplication{}
+
+	if err := r.Get(ctx, req.NamespacedName, application); err != nil {
+		r.Log.Error(err, "unable to fetch application")
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	handler := newApplicationReconcileHandler(r.Client, r.SecureAccessCloudClients, r.Recorder, r.ResyncInterval, r.Log.WithValues("application", application.Name))
+	return handler.reconcileApplication(ctx, application, &application.Status, nil,
+		func() error {
+			return r.ConverterToModel.Validate(application)
+		},
+		func([]*corev1.ServicePort) (*model.Application, error) {
+			return r.ConverterToModel.ConvertToModel(application)
+		})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TcpApplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return setupApplicationController(mgr, r, &accessv1.TcpApplication{}, &accessv1.TcpApplicationList{},
+		func(rawObj client.Object) accessv1.CommonApplicationParams {
+			return rawObj.(*accessv1.TcpApplication).Spec.CommonApplicationParams
+		},
+		nil, r.Log)
+}
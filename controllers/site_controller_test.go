@@ -61,7 +61,7 @@ var _ = Describe("SiteName controller", func() {
 		AfterEach(func() {
 			if siteDto.ID != "" {
 				fmt.Fprintf(GinkgoWriter, "deleting site %s in k8s\n", site.Name)
-				err := sacClient.DeleteSite(siteDto.ID)
+				err := sacClient.DeleteSite(ctx, siteDto.ID)
 				Expect(err).NotTo(HaveOccurred())
 			}
 		})
@@ -85,7 +85,7 @@ var _ = Describe("SiteName controller", func() {
 			Eventually(func() bool {
 				var err error
 				fmt.Fprintf(GinkgoWriter, "looking for site in sac %s\n", createdSite.Name)
-				siteDto, err = sacClient.FindSiteByName(createdSite.Name)
+				siteDto, err = sacClient.FindSiteByName(ctx, createdSite.Name)
 				if err != nil {
 					return false
 				}
@@ -105,7 +105,7 @@ var _ = Describe("SiteName controller", func() {
 			fmt.Fprintf(GinkgoWriter, "looking for connectors site in sac %s\n", createdSite.Name)
 			Eventually(func() bool {
 				var err error
-				connectorsInSac, err = sacClient.ListConnectorsBySite(createdSite.Name)
+				connectorsInSac, err = sacClient.ListConnectorsBySite(ctx, createdSite.Name)
 				if err != nil {
 					return false
 				}
@@ -22,8 +22,6 @@ import (
 	"path/filepath"
 	"testing"
 
-	"bitbucket.org/accezz-io/sac-operator/service"
-
 	"bitbucket.org/accezz-io/sac-operator/controllers/access"
 
 	"bitbucket.org/accezz-io/sac-operator/service/sac"
@@ -52,6 +50,7 @@ var (
 	cfg        *rest.Config
 	k8sClient  client.Client
 	sacClient  sac.SecureAccessCloudClient
+	sacClients *sac.SecureAccessCloudClientRegistry
 	testEnv    *envtest.Environment
 	ctx        context.Context
 	cancel     context.CancelFunc
@@ -104,24 +103,113 @@ var _ = BeforeSuite(func() {
 		ClientSecret: sacClientSecret,
 		TenantDomain: sacTenantDomain,
 	}
-	sacClient = sac.NewSecureAccessCloudClientImpl(secureAccessCloudSettings)
+	sacClients = sac.NewSecureAccessCloudClientRegistry()
+	sacClient = sacClients.Register(sac.DefaultTenant, *secureAccessCloudSettings)
+
+	err = (&access.SecureAccessCloudTenantReconciler{
+		Client:                   k8sManager.GetClient(),
+		Scheme:                   k8sManager.GetScheme(),
+		SecureAccessCloudClients: sacClients,
+		Converter:                converter.NewSecureAccessCloudTenantConverter(),
+		Log:                      ctrl.Log.WithName("test-tenant-reconcile"),
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
 
 	err = (&access.SiteReconcile{
-		Client:                  k8sManager.GetClient(),
-		Scheme:                  k8sManager.GetScheme(),
-		SecureAccessCloudClient: sacClient,
-		SiteConverter:           converter.NewSiteConverter(),
-		Log:                     ctrl.Log.WithName("test-site-reconcile"),
+		Client:                   k8sManager.GetClient(),
+		Scheme:                   k8sManager.GetScheme(),
+		SecureAccessCloudClients: sacClients,
+		Recorder:                 k8sManager.GetEventRecorderFor("sac-operator"),
+		SiteConverter:            converter.NewSiteConverter(),
+		Log:                      ctrl.Log.WithName("test-site-reconcile"),
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
-	applicationReconcilerLogger := ctrl.Log.WithName("application-reconcile")
 	err = (&access.HttpApplicationReconciler{
-		Client:             k8sManager.GetClient(),
-		Scheme:             k8sManager.GetScheme(),
-		ApplicationService: service.NewApplicationServiceImpl(sacClient, applicationReconcilerLogger),
-		ConverterToModel:   converter.NewHttpApplicationTypeConverter(),
-		Log:                ctrl.Log.WithName("test-application-reconcile"),
+		Client:                   k8sManager.GetClient(),
+		Scheme:                   k8sManager.GetScheme(),
+		SecureAccessCloudClients: sacClients,
+		Recorder:                 k8sManager.GetEventRecorderFor("sac-operator"),
+		ConverterToModel:         converter.NewHttpApplicationTypeConverter(),
+		Log:                      ctrl.Log.WithName("test-application-reconcile"),
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&access.SshApplicationReconciler{
+		Client:                   k8sManager.GetClient(),
+		Scheme:                   k8sManager.GetScheme(),
+		SecureAccessCloudClients: sacClients,
+		Recorder:                 k8sManager.GetEventRecorderFor("sac-operator"),
+		ConverterToModel:         converter.NewSshApplicationTypeConverter(),
+		Log:                      ctrl.Log.WithName("test-ssh-application-reconcile"),
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&access.RdpApplicationReconciler{
+		Client:                   k8sManager.GetClient(),
+		Scheme:                   k8sManager.GetScheme(),
+		SecureAccessCloudClients: sacClients,
+		Recorder:                 k8sManager.GetEventRecorderFor("sac-operator"),
+		ConverterToModel:         converter.NewRdpApplicationTypeConverter(),
+		Log:                      ctrl.Log.WithName("test-rdp-application-reconcile"),
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&access.TcpApplicationReconciler{
+		Client:                   k8sManager.GetClient(),
+		Scheme:                   k8sManager.GetScheme(),
+		SecureAccessCloudClients: sacClients,
+		Recorder:                 k8sManager.GetEventRecorderFor("sac-operator"),
+		ConverterToModel:         converter.NewTcpApplicationTypeConverter(),
+		Log:                      ctrl.Log.WithName("test-tcp-application-reconcile"),
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&access.DynamicSshApplicationReconciler{
+		Client:                   k8sManager.GetClient(),
+		Scheme:                   k8sManager.GetScheme(),
+		SecureAccessCloudClients: sacClients,
+		Recorder:                 k8sManager.GetEventRecorderFor("sac-operator"),
+		ConverterToModel:         converter.NewDynamicSshApplicationTypeConverter(),
+		Log:                      ctrl.Log.WithName("test-dynamic-ssh-application-reconcile"),
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&access.AccessPolicyReconciler{
+		Client:                   k8sManager.GetClient(),
+		Scheme:                   k8sManager.GetScheme(),
+		SecureAccessCloudClients: sacClients,
+		Recorder:                 k8sManager.GetEventRecorderFor("sac-operator"),
+		ConverterToModel:         converter.NewAccessPolicyConverter(),
+		Log:                      ctrl.Log.WithName("test-access-policy-reconcile"),
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&access.ActivityPolicyReconciler{
+		Client:                   k8sManager.GetClient(),
+		Scheme:                   k8sManager.GetScheme(),
+		SecureAccessCloudClients: sacClients,
+		Recorder:                 k8sManager.GetEventRecorderFor("sac-operator"),
+		ConverterToModel:         converter.NewActivityPolicyConverter(),
+		Log:                      ctrl.Log.WithName("test-activity-policy-reconcile"),
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&access.IngressReconciler{
+		Client:    k8sManager.GetClient(),
+		Scheme:    k8sManager.GetScheme(),
+		Recorder:  k8sManager.GetEventRecorderFor("sac-operator"),
+		Converter: converter.NewIngressConverter("secure-access-cloud"),
+		Log:       ctrl.Log.WithName("test-ingress-reconcile"),
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&access.ExposedServiceReconciler{
+		Client:    k8sManager.GetClient(),
+		Scheme:    k8sManager.GetScheme(),
+		Recorder:  k8sManager.GetEventRecorderFor("sac-operator"),
+		Converter: converter.NewExposedServiceConverter(),
+		Log:       ctrl.Log.WithName("test-exposed-service-reconcile"),
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
@@ -20,11 +20,11 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"time"
 
 	"bitbucket.org/accezz-io/sac-operator/service/sac"
 
 	"bitbucket.org/accezz-io/sac-operator/controllers/access/converter"
-	"bitbucket.org/accezz-io/sac-operator/service"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -36,8 +36,11 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	accessv1 "bitbucket.org/accezz-io/sac-operator/apis/access/v1"
+	accessv2 "bitbucket.org/accezz-io/sac-operator/apis/access/v2"
 	accesscontrollers "bitbucket.org/accezz-io/sac-operator/controllers/access"
 	//+kubebuilder:scaffold:imports
 )
@@ -51,6 +54,8 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(accessv1.AddToScheme(scheme))
+	utilruntime.Must(accessv2.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1alpha2.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
 }
 
@@ -59,6 +64,16 @@ func main() {
 	var enableLeaderElection bool
 	var probeAddr string
 	var configFile string
+	var sacCredentialsDir string
+	var sacCredentialsPollInterval time.Duration
+	var resyncInterval time.Duration
+	var ingressClassName string
+	var enableGatewayAPI bool
+	var webhookVerifySacReferences bool
+	var siteMinConnectors, siteMaxConnectors int
+	var sacRateLimit float64
+	var sacRateBurst int
+	var sacInventoryTTL time.Duration
 	flag.StringVar(&configFile, "config", "",
 		"The controller will load its initial configuration from this file. "+
 			"Omit this flag to use the default configuration values. "+
@@ -68,6 +83,36 @@ func main() {
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.StringVar(&sacCredentialsDir, "sac-credentials-dir", "",
+		"A directory holding the tenantDomain, clientId and clientSecret files of the default tenant, e.g. a mounted secret. "+
+			"The directory is watched and rotated credentials are used without restarting the operator.")
+	flag.DurationVar(&sacCredentialsPollInterval, "sac-credentials-poll-interval", 30*time.Second,
+		"The interval in which the sac-credentials-dir is checked for rotated credentials.")
+	flag.DurationVar(&resyncInterval, "resync-interval", 10*time.Minute,
+		"The interval in which sites and applications are reconciled again in order to detect, and correct or report, "+
+			"drifts made in Secure-Access-Cloud. Set to 0 to disable the periodic reconcile.")
+	flag.StringVar(&ingressClassName, "ingress-class", "secure-access-cloud",
+		"The IngressClass of the Ingresses which are exposed in Secure-Access-Cloud by generated HttpApplications.")
+	flag.BoolVar(&enableGatewayAPI, "enable-gateway-api", false,
+		"Expose the HTTPRoutes attached to the Gateways of the operator GatewayClasses in Secure-Access-Cloud. "+
+			"Requires the Gateway API CRDs to be installed.")
+	flag.BoolVar(&webhookVerifySacReferences, "webhook-verify-sac-references", false,
+		"Reject HttpApplications referencing a site or policies which do not exist in Secure-Access-Cloud. "+
+			"Every admission request is then looked up in Secure-Access-Cloud.")
+	flag.IntVar(&siteMinConnectors, "site-min-connectors", 1,
+		"The minimum number_of_connectors of a Site, and its default. Overridden per namespace by the "+
+			"access.secure-access-cloud.symantec.com/min-connectors namespace annotation.")
+	flag.IntVar(&siteMaxConnectors, "site-max-connectors", 10,
+		"The maximum number_of_connectors of a Site. Overridden per namespace by the "+
+			"access.secure-access-cloud.symantec.com/max-connectors namespace annotation.")
+	flag.Float64Var(&sacRateLimit, "sac-rate-limit", 10,
+		"The maximum number of requests per second to a Secure-Access-Cloud tenant, shared by all the controllers. "+
+			"Deletions go before the routine requests when requests are throttled. Set to 0 to disable the rate limiting.")
+	flag.IntVar(&sacRateBurst, "sac-rate-burst", 20,
+		"The maximum number of requests sent at once to a Secure-Access-Cloud tenant before sac-rate-limit applies.")
+	flag.DurationVar(&sacInventoryTTL, "sac-inventory-ttl", 5*time.Minute,
+		"The duration the sites, policies and applications of a Secure-Access-Cloud tenant are cached to resolve them by "+
+			"name, before they are listed again. Set to 0 to look every name up in Secure-Access-Cloud.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -99,42 +144,234 @@ func main() {
 		}
 	}
 
+	// The SAC_* environment variables, or the sac-credentials-dir flag, are optional and configure the default tenant,
+	// used by the objects which do not reference a SecureAccessCloudTenant.
+	sacClients := sac.NewSecureAccessCloudClientRegistry().
+		SetRateLimit(sacRateLimit, sacRateBurst).
+		SetInventoryTTL(sacInventoryTTL)
 	sacClientID, sacClientSecret, sacTenantDomain := os.Getenv("SAC_CLIENT_ID"), os.Getenv("SAC_CLIENT_SECRET"), os.Getenv("SAC_TENANT_DOMAIN")
-	if sacClientID == "" || sacClientSecret == "" || sacTenantDomain == "" {
-		setupLog.Error(fmt.Errorf("missing environment variable required for tests. SAC_CLIENT_ID, SAC_CLIENT_SECRET and SAC_TENANT_DOMAIN must all be set"), "")
+	switch {
+	case sacCredentialsDir != "" && (sacClientID != "" || sacClientSecret != "" || sacTenantDomain != ""):
+		setupLog.Error(fmt.Errorf("the default tenant is configured by both the SAC_* environment variables and the sac-credentials-dir flag"), "")
 		os.Exit(1)
+	case sacCredentialsDir != "":
+		settings, err := sac.ReadSettingsFromDir(sacCredentialsDir)
+		if err != nil {
+			setupLog.Error(err, "unable to read the default tenant credentials")
+			os.Exit(1)
+		}
+		sacClients.Register(sac.DefaultTenant, *settings)
+		if err = mgr.Add(&sac.CredentialsFileWatcher{
+			Dir:      sacCredentialsDir,
+			Tenant:   sac.DefaultTenant,
+			Registry: sacClients,
+			Interval: sacCredentialsPollInterval,
+			Log:      ctrl.Log.WithName("credentials-watcher"),
+		}); err != nil {
+			setupLog.Error(err, "unable to add the default tenant credentials watcher")
+			os.Exit(1)
+		}
+	case sacClientID != "" && sacClientSecret != "" && sacTenantDomain != "":
+		sacClients.Register(sac.DefaultTenant, sac.SecureAccessCloudSettings{
+			ClientID:     sacClientID,
+			ClientSecret: sacClientSecret,
+			TenantDomain: sacTenantDomain,
+		})
+	case sacClientID != "" || sacClientSecret != "" || sacTenantDomain != "":
+		setupLog.Error(fmt.Errorf("incomplete default tenant configuration. SAC_CLIENT_ID, SAC_CLIENT_SECRET and SAC_TENANT_DOMAIN must all be set"), "")
+		os.Exit(1)
+	default:
+		setupLog.Info("no default tenant configured, objects must reference a SecureAccessCloudTenant")
 	}
-
-	secureAccessCloudSettings := &sac.SecureAccessCloudSettings{
-		ClientID:     sacClientID,
-		ClientSecret: sacClientSecret,
-		TenantDomain: sacTenantDomain,
+	if err = mgr.Add(manager.RunnableFunc(sacClients.WarmInventories)); err != nil {
+		setupLog.Error(err, "unable to add the inventory cache warmer")
+		os.Exit(1)
 	}
 
-	sacClient := sac.NewSecureAccessCloudClientImpl(secureAccessCloudSettings)
-
+	tenantReconcilerLogger := ctrl.Log.WithName("tenant-reconcile")
+	if err = (&accesscontrollers.SecureAccessCloudTenantReconciler{
+		Client:                   mgr.GetClient(),
+		Scheme:                   mgr.GetScheme(),
+		SecureAccessCloudClients: sacClients,
+		Converter:                converter.NewSecureAccessCloudTenantConverter(),
+		Log:                      tenantReconcilerLogger,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SecureAccessCloudTenant")
+		os.Exit(1)
+	}
 	siteReconcilerLogger := ctrl.Log.WithName("site-reconcile")
 	if err = (&accesscontrollers.SiteReconcile{
-		Client:                  mgr.GetClient(),
-		Scheme:                  mgr.GetScheme(),
-		SecureAccessCloudClient: sacClient,
-		SiteConverter:           converter.NewSiteConverter(),
-		Log:                     siteReconcilerLogger,
+		Client:                   mgr.GetClient(),
+		Scheme:                   mgr.GetScheme(),
+		SecureAccessCloudClients: sacClients,
+		Recorder:                 mgr.GetEventRecorderFor("sac-operator"),
+		ResyncInterval:           resyncInterval,
+		SiteConverter:            converter.NewSiteConverter(),
+		Log:                      siteReconcilerLogger,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "SiteName")
 		os.Exit(1)
 	}
 	applicationReconcilerLogger := ctrl.Log.WithName("application-reconcile")
 	if err = (&accesscontrollers.HttpApplicationReconciler{
-		Client:             mgr.GetClient(),
-		Scheme:             mgr.GetScheme(),
-		ApplicationService: service.NewApplicationServiceImpl(sacClient, applicationReconcilerLogger),
-		ConverterToModel:   converter.NewHttpApplicationTypeConverter(),
-		Log:                applicationReconcilerLogger,
+		Client:                   mgr.GetClient(),
+		Scheme:                   mgr.GetScheme(),
+		SecureAccessCloudClients: sacClients,
+		Recorder:                 mgr.GetEventRecorderFor("sac-operator"),
+		ResyncInterval:           resyncInterval,
+		ConverterToModel:         converter.NewHttpApplicationTypeConverter(),
+		Log:                      applicationReconcilerLogger,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "HttpApplication")
 		os.Exit(1)
 	}
+	sshApplicationReconcilerLogger := ctrl.Log.WithName("ssh-application-reconcile")
+	if err = (&accesscontrollers.SshApplicationReconciler{
+		Client:                   mgr.GetClient(),
+		Scheme:                   mgr.GetScheme(),
+		SecureAccessCloudClients: sacClients,
+		Recorder:                 mgr.GetEventRecorderFor("sac-operator"),
+		ResyncInterval:           resyncInterval,
+		ConverterToModel:         converter.NewSshApplicationTypeConverter(),
+		Log:                      sshApplicationReconcilerLogger,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SshApplication")
+		os.Exit(1)
+	}
+	rdpApplicationReconcilerLogger := ctrl.Log.WithName("rdp-application-reconcile")
+	if err = (&accesscontrollers.RdpApplicationReconciler{
+		Client:                   mgr.GetClient(),
+		Scheme:                   mgr.GetScheme(),
+		SecureAccessCloudClients: sacClients,
+		Recorder:                 mgr.GetEventRecorderFor("sac-operator"),
+		ResyncInterval:           resyncInterval,
+		ConverterToModel:         converter.NewRdpApplicationTypeConverter(),
+		Log:                      rdpApplicationReconcilerLogger,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RdpApplication")
+		os.Exit(1)
+	}
+	tcpApplicationReconcilerLogger := ctrl.Log.WithName("tcp-application-reconcile")
+	if err = (&accesscontrollers.TcpApplicationReconciler{
+		Client:                   mgr.GetClient(),
+		Scheme:                   mgr.GetScheme(),
+		SecureAccessCloudClients: sacClients,
+		Recorder:                 mgr.GetEventRecorderFor("sac-operator"),
+		ResyncInterval:           resyncInterval,
+		ConverterToModel:         converter.NewTcpApplicationTypeConverter(),
+		Log:                      tcpApplicationReconcilerLogger,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TcpApplication")
+		os.Exit(1)
+	}
+	dynamicSshApplicationReconcilerLogger := ctrl.Log.WithName("dynamic-ssh-application-reconcile")
+	if err = (&accesscontrollers.DynamicSshApplicationReconciler{
+		Client:                   mgr.GetClient(),
+		Scheme:                   mgr.GetScheme(),
+		SecureAccessCloudClients: sacClients,
+		Recorder:                 mgr.GetEventRecorderFor("sac-operator"),
+		ResyncInterval:           resyncInterval,
+		ConverterToModel:         converter.NewDynamicSshApplicationTypeConverter(),
+		Log:                      dynamicSshApplicationReconcilerLogger,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DynamicSshApplication")
+		os.Exit(1)
+	}
+	accessPolicyReconcilerLogger := ctrl.Log.WithName("access-policy-reconcile")
+	if err = (&accesscontrollers.AccessPolicyReconciler{
+		Client:                   mgr.GetClient(),
+		Scheme:                   mgr.GetScheme(),
+		SecureAccessCloudClients: sacClients,
+		Recorder:                 mgr.GetEventRecorderFor("sac-operator"),
+		ConverterToModel:         converter.NewAccessPolicyConverter(),
+		Log:                      accessPolicyReconcilerLogger,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AccessPolicy")
+		os.Exit(1)
+	}
+	activityPolicyReconcilerLogger := ctrl.Log.WithName("activity-policy-reconcile")
+	if err = (&accesscontrollers.ActivityPolicyReconciler{
+		Client:                   mgr.GetClient(),
+		Scheme:                   mgr.GetScheme(),
+		SecureAccessCloudClients: sacClients,
+		Recorder:                 mgr.GetEventRecorderFor("sac-operator"),
+		ConverterToModel:         converter.NewActivityPolicyConverter(),
+		Log:                      activityPolicyReconcilerLogger,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ActivityPolicy")
+		os.Exit(1)
+	}
+	ingressReconcilerLogger := ctrl.Log.WithName("ingress-reconcile")
+	if err = (&accesscontrollers.IngressReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Recorder:  mgr.GetEventRecorderFor("sac-operator"),
+		Converter: converter.NewIngressConverter(ingressClassName),
+		Log:       ingressReconcilerLogger,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Ingress")
+		os.Exit(1)
+	}
+	exposedServiceReconcilerLogger := ctrl.Log.WithName("exposed-service-reconcile")
+	if err = (&accesscontrollers.ExposedServiceReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Recorder:  mgr.GetEventRecorderFor("sac-operator"),
+		Converter: converter.NewExposedServiceConverter(),
+		Log:       exposedServiceReconcilerLogger,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ExposedService")
+		os.Exit(1)
+	}
+	if enableGatewayAPI {
+		if err = (&accesscontrollers.GatewayClassReconciler{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+			Log:    ctrl.Log.WithName("gateway-class-reconcile"),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "GatewayClass")
+			os.Exit(1)
+		}
+		if err = (&accesscontrollers.GatewayReconciler{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+			Log:    ctrl.Log.WithName("gateway-reconcile"),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Gateway")
+			os.Exit(1)
+		}
+		if err = (&accesscontrollers.HTTPRouteReconciler{
+			Client:    mgr.GetClient(),
+			Scheme:    mgr.GetScheme(),
+			Recorder:  mgr.GetEventRecorderFor("sac-operator"),
+			Converter: converter.NewHTTPRouteConverter(),
+			Log:       ctrl.Log.WithName("httproute-reconcile"),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "HTTPRoute")
+			os.Exit(1)
+		}
+	}
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err = (&accesscontrollers.HttpApplicationValidator{
+			Client:                            mgr.GetClient(),
+			SecureAccessCloudClients:          sacClients,
+			Converter:                         converter.NewHttpApplicationTypeConverter(),
+			VerifySecureAccessCloudReferences: webhookVerifySacReferences,
+			Log:                               ctrl.Log.WithName("httpapplication-webhook"),
+		}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "HttpApplication")
+			os.Exit(1)
+		}
+		if err = (&accesscontrollers.SiteWebhook{
+			Client:        mgr.GetClient(),
+			MinConnectors: siteMinConnectors,
+			MaxConnectors: siteMaxConnectors,
+			Log:           ctrl.Log.WithName("site-webhook"),
+		}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Site")
+			os.Exit(1)
+		}
+	}
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -6,12 +6,17 @@ import (
 
 type CommonApplicationParams struct {
 	Name                  string
+	TenantName            string
 	SiteName              string
 	IsVisible             bool
 	IsNotificationEnabled bool
 	Enabled               bool
 	AccessPoliciesNames   []string
 	ActivityPoliciesNames []string
+	// The SAC ids of the referenced policies which were resolved from the cluster, by policy type and name. Policies
+	// missing from here are looked up by name in Secure-Access-Cloud.
+	ResolvedPoliciesIDs map[PolicyReference]string
+	DriftPolicy         DriftPolicy
 }
 
 type ConnectionSettings struct {
@@ -36,17 +41,34 @@ type HttpRequestCustomizationSettings struct {
 	HeaderCustomization map[string]string
 }
 
+type TcpTunnelSetting struct {
+	Target string
+	Ports  []string
+}
+
+type SshSettings struct {
+	UserAccounts        []string
+	FullUPNAutoGenerate bool
+	UPNAutoGenerate     bool
+	EmailAutoGenerate   bool
+}
+
 type Application struct {
 	ID       string
 	Type     ApplicationType
 	SubType  ApplicationSubType
 	ToDelete bool
+	// Whether the application spec changed since it was last reconciled, a drift is then expected and is always
+	// corrected.
+	SpecChanged bool
 
 	CommonApplicationParams
 
 	*ConnectionSettings
 	*HttpLinkTranslationSettings
 	*HttpRequestCustomizationSettings
+	*SshSettings
+	TcpTunnelSettings []TcpTunnelSetting
 }
 
 func (a *Application) String() string {
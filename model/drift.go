@@ -0,0 +1,21 @@
+package model
+
+// DriftPolicy defines what the operator does when an object was modified or deleted in Secure-Access-Cloud, outside
+// the cluster.
+type DriftPolicy string
+
+const (
+	// DriftPolicyCorrect overrides the drifted fields, and recreates deleted objects, with the desired state.
+	DriftPolicyCorrect DriftPolicy = "Correct"
+	// DriftPolicyReport only reports the drift in the object status and events.
+	DriftPolicyReport DriftPolicy = "Report"
+)
+
+// DriftDeleted is the drift reported when the object was deleted in Secure-Access-Cloud.
+const DriftDeleted = "deleted"
+
+// The drifts reported when an application is no longer bound to its site, or to one of its policies.
+const (
+	DriftSite     = "site"
+	DriftPolicies = "policies"
+)
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"fmt"
+)
+
+type DirectoryEntity struct {
+	IdentifierInProvider string
+	IdentityProviderID   string
+	IdentityProviderType string
+	Type                 string
+	DisplayName          string
+}
+
+type FilterCondition struct {
+	Type      string
+	Positive  bool
+	Arguments map[string][]string
+}
+
+type PolicyRuleCondition struct {
+	Type      string
+	Arguments map[string][]string
+}
+
+type PolicyRuleTarget struct {
+	Type      string
+	Arguments map[string][]string
+}
+
+type PolicyRule struct {
+	Action     string
+	Conditions []PolicyRuleCondition
+	Targets    []PolicyRuleTarget
+}
+
+type Policy struct {
+	ID             string
+	Name           string
+	TenantName     string
+	Type           PolicyType
+	TargetProtocol ApplicationType
+	Enabled        bool
+	ToDelete       bool
+
+	DirectoryEntities []DirectoryEntity
+	FilterConditions  []FilterCondition
+	Rules             []PolicyRule
+}
+
+func (p *Policy) String() string {
+	return fmt.Sprintf("%#v", p)
+}
+
+type PolicyType string
+
+const (
+	AccessPolicy   PolicyType = "ACCESS"
+	ActivityPolicy PolicyType = "ACTIVITY"
+)
+
+func (p PolicyType) String() string {
+	return string(p)
+}
+
+// PolicyReference references a policy by its type and name, an access and an activity policy may have the same name.
+type PolicyReference struct {
+	Type PolicyType
+	Name string
+}
@@ -6,9 +6,13 @@ type ConnectorConfiguration struct {
 
 type Site struct {
 	Name                   string
+	TenantName             string
 	SACSiteID              string
 	NumberOfConnectors     int
 	SiteNamespace          string
 	ToDelete               bool
 	ConnectorConfiguration *ConnectorConfiguration
+	DriftPolicy            DriftPolicy
+	// Whether the site spec changed since it was last reconciled, a drift is then expected and is always corrected.
+	SpecChanged bool
 }
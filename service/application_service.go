@@ -14,4 +14,8 @@ type ApplicationService interface {
 type ApplicationReconcileOutput struct {
 	Deleted          bool
 	SACApplicationID string
+	SiteBound        bool
+	PoliciesBound    bool
+	// The fields which drifted in Secure-Access-Cloud and were only reported, according to the drift policy
+	Drift []string
 }
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/go-logr/logr"
 
@@ -21,6 +22,7 @@ type applicationObjectIds struct {
 
 type ApplicationServiceImpl struct {
 	sacClient sac.SecureAccessCloudClient
+	events    EventRecorder
 	log       logr.Logger
 }
 
@@ -33,10 +35,12 @@ func (a *ApplicationServiceImpl) Reconcile(ctx context.Context, application *mod
 	}
 
 	if application.ToDelete {
+		// the deletion blocks the removal of the finalizer, it goes first through the rate limiter
+		ctx = sac.WithPriority(ctx, sac.PriorityHigh)
 		if application.ID == "" {
 			return output, fmt.Errorf("application ID is nil, %w", typederror.UnrecoverableError)
 		}
-		err := a.delete(application.ID)
+		err := a.delete(ctx, application.ID)
 		if err != nil {
 			return output, err
 		}
@@ -44,27 +48,36 @@ func (a *ApplicationServiceImpl) Reconcile(ctx context.Context, application *mod
 		return output, nil
 	}
 
-	ids, err := a.getSiteAndPoliciesIDs(application)
+	ids, err := a.getSiteAndPoliciesIDs(ctx, application)
 	if err != nil {
 		return output, err
 	}
 
+	previousID := application.ID
 	if application.ID == "" {
-		err = a.create(application)
+		err = a.create(ctx, application)
 		if err != nil {
 			return output, err
 		}
 	} else {
-		err = a.updateApplication(application)
+		err = a.updateApplication(ctx, application, ids, output)
 		if err != nil {
 			output.SACApplicationID = application.ID
 			return output, err
 		}
+		if len(output.Drift) > 0 {
+			// the drift is only reported, the application is left as found in Secure-Access-Cloud
+			output.SACApplicationID = application.ID
+			return output, nil
+		}
 	}
 
 	output.SACApplicationID = application.ID
 
-	err = a.updateSiteAndPolicies(application, ids)
+	// the binding is made on every reconcile, the created and recreated applications and the spec changes only are
+	// announced
+	bindingChanged := application.ID != previousID || application.SpecChanged
+	err = a.updateSiteAndPolicies(ctx, application, ids, bindingChanged, output)
 	if err != nil {
 		return output, err
 	}
@@ -72,20 +85,31 @@ func (a *ApplicationServiceImpl) Reconcile(ctx context.Context, application *mod
 	return output, nil
 }
 
-func NewApplicationServiceImpl(sacClient sac.SecureAccessCloudClient, logger logr.Logger) ApplicationService {
-	return &ApplicationServiceImpl{sacClient: sacClient, log: logger}
+func NewApplicationServiceImpl(sacClient sac.SecureAccessCloudClient, logger logr.Logger) *ApplicationServiceImpl {
+	return &ApplicationServiceImpl{sacClient: sacClient, events: noopEventRecorder{}, log: logger}
+}
+
+// SetEventRecorder sets the recorder of the events about the reconciled application.
+func (a *ApplicationServiceImpl) SetEventRecorder(events EventRecorder) *ApplicationServiceImpl {
+	a.events = events
+	return a
 }
 
-func (a *ApplicationServiceImpl) create(applicationToCreate *model.Application) error {
+func (a *ApplicationServiceImpl) create(ctx context.Context, applicationToCreate *model.Application) error {
 	a.log.Info("creating application: " + applicationToCreate.String())
 
 	// 1. Find Application by Name to verify the name isn't used
-	appInSac, err := a.sacClient.FindApplicationByName(applicationToCreate.Name)
-	if err != nil && err != sac.ErrorNotFound {
-		return err
+	appInSac, err := a.sacClient.FindApplicationByName(ctx, applicationToCreate.Name)
+	if errors.Is(err, sac.ErrorAmbiguousName) {
+		warningEvent(a.events, EventReasonAlreadyExists, "application %s already exists in Secure-Access-Cloud: %s", applicationToCreate.Name, err)
+		return fmt.Errorf("%w application %s already exist: %s", typederror.UnrecoverableError, applicationToCreate.Name, err)
+	}
+	if err != nil && !errors.Is(err, sac.ErrorNotFound) {
+		return sacError("look up application "+applicationToCreate.Name, err)
 	}
 
 	if appInSac.ID != "" {
+		warningEvent(a.events, EventReasonAlreadyExists, "application %s already exists in Secure-Access-Cloud", applicationToCreate.Name)
 		return fmt.Errorf("%w application %s already exist %s", typederror.UnrecoverableError, applicationToCreate.Name, appInSac.ID)
 	}
 
@@ -94,37 +118,69 @@ func (a *ApplicationServiceImpl) create(applicationToCreate *model.Application)
 	if err != nil {
 		return fmt.Errorf("%w could not convert to sac application %s %s", typederror.UnrecoverableError, applicationToCreate.Name, appInSac.ID)
 	}
-	createdApplicationDTO, err := a.sacClient.CreateApplication(applicationDTO)
+	createdApplicationDTO, err := a.sacClient.CreateApplication(ctx, applicationDTO)
 	if err != nil {
-		return err
+		if sac.IsConflict(err) {
+			warningEvent(a.events, EventReasonAlreadyExists, "application %s already exists in Secure-Access-Cloud", applicationToCreate.Name)
+			return fmt.Errorf("%w application %s already exist", typederror.UnrecoverableError, applicationToCreate.Name)
+		}
+		return sacError("create application "+applicationToCreate.Name, err)
 	}
 	applicationToCreate.ID = createdApplicationDTO.ID
+	normalEvent(a.events, EventReasonApplicationCreated, "created application %s in Secure-Access-Cloud", applicationToCreate.ID)
 
 	return nil
 }
 
-func (a *ApplicationServiceImpl) getSiteAndPoliciesIDs(applicationToCreate *model.Application) (*applicationObjectIds, error) {
+func (a *ApplicationServiceImpl) getSiteAndPoliciesIDs(ctx context.Context, applicationToCreate *model.Application) (*applicationObjectIds, error) {
 
 	ids := &applicationObjectIds{}
 
 	// 2. Validate SiteName Exists
-	site, err := a.sacClient.FindSiteByName(applicationToCreate.SiteName)
+	site, err := a.sacClient.FindSiteByName(ctx, applicationToCreate.SiteName)
 	if err != nil {
 		if errors.Is(err, sac.ErrorNotFound) {
+			warningEvent(a.events, EventReasonSiteNotFound, "site %s does not exist", applicationToCreate.SiteName)
 			return ids, fmt.Errorf("%w site %s does not exist", typederror.UnrecoverableError, applicationToCreate.SiteName)
 		}
-		return ids, fmt.Errorf("%w could not validate site status", err)
+		if errors.Is(err, sac.ErrorAmbiguousName) {
+			warningEvent(a.events, EventReasonAmbiguousName, "cannot resolve site: %s", err)
+			return ids, fmt.Errorf("%w cannot resolve site: %s", typederror.UnrecoverableError, err)
+		}
+		return ids, sacError("validate site "+applicationToCreate.SiteName, err)
 	}
 
 	ids.siteId = site.ID
 
 	// 3. Validate Policies Exists
-	policies, err := a.sacClient.FindPoliciesByNames(append(applicationToCreate.AccessPoliciesNames, applicationToCreate.ActivityPoliciesNames...))
+	var policiesToFind []model.PolicyReference
+	for _, name := range applicationToCreate.AccessPoliciesNames {
+		policiesToFind = append(policiesToFind, model.PolicyReference{Type: model.AccessPolicy, Name: name})
+	}
+	for _, name := range applicationToCreate.ActivityPoliciesNames {
+		policiesToFind = append(policiesToFind, model.PolicyReference{Type: model.ActivityPolicy, Name: name})
+	}
+
+	var policiesReferencesToFind []model.PolicyReference
+	for _, reference := range policiesToFind {
+		if id, ok := applicationToCreate.ResolvedPoliciesIDs[reference]; ok {
+			ids.policiesIds = append(ids.policiesIds, id)
+			continue
+		}
+		policiesReferencesToFind = append(policiesReferencesToFind, reference)
+	}
+
+	policies, err := a.sacClient.FindPoliciesByNames(ctx, policiesReferencesToFind)
 	if err != nil {
 		if errors.Is(err, sac.ErrorNotFound) {
+			warningEvent(a.events, EventReasonPolicyNotFound, "policy does not exist: %s", err)
 			return ids, fmt.Errorf("%w policy does not exist %s", typederror.UnrecoverableError, err)
 		}
-		return ids, fmt.Errorf("%w could not validate policy status", err)
+		if errors.Is(err, sac.ErrorAmbiguousName) {
+			warningEvent(a.events, EventReasonAmbiguousName, "cannot resolve policy: %s", err)
+			return ids, fmt.Errorf("%w cannot resolve policy: %s", typederror.UnrecoverableError, err)
+		}
+		return ids, sacError("validate policies", err)
 	}
 
 	for i := range policies {
@@ -134,96 +190,199 @@ func (a *ApplicationServiceImpl) getSiteAndPoliciesIDs(applicationToCreate *mode
 	return ids, nil
 }
 
-func (a *ApplicationServiceImpl) updateSiteAndPolicies(application *model.Application, ids *applicationObjectIds) error {
+// updateSiteAndPolicies binds the application to its site and policies. The SiteBound and PoliciesBound events are
+// recorded only when the binding changed, not on every resync.
+func (a *ApplicationServiceImpl) updateSiteAndPolicies(ctx context.Context, application *model.Application, ids *applicationObjectIds, bindingChanged bool, output *ApplicationReconcileOutput) error {
 
 	// 5. Bind SiteName & Policies (Idempotent)
-	err := a.bindSiteToApplication(application.ID, ids.siteId)
+	err := a.bindSiteToApplication(ctx, application.ID, ids.siteId)
 	if err != nil {
 		return err
 	}
+	output.SiteBound = true
+	if bindingChanged {
+		normalEvent(a.events, EventReasonSiteBound, "bound application to site %s", application.SiteName)
+	}
 
 	// 5. Bind SiteName & Policies (Idempotent)
-	err = a.bindPoliciesToApplication(application.ID, application.Type, ids.policiesIds)
+	err = a.bindPoliciesToApplication(ctx, application.ID, application.Type, ids.policiesIds)
 	if err != nil {
 		return err
 	}
+	output.PoliciesBound = true
+	if bindingChanged && len(ids.policiesIds) > 0 {
+		normalEvent(a.events, EventReasonPoliciesBound, "bound application to %d policies", len(ids.policiesIds))
+	}
 
 	return nil
 }
 
-func (a *ApplicationServiceImpl) updateApplication(application *model.Application) error {
+// updateApplication updates the application in Secure-Access-Cloud when it differs from the desired application, or
+// is not bound to its site and policies anymore. When the spec did not change, such a difference is a drift made
+// outside the cluster, which is corrected or only reported according to the application drift policy. The binding
+// itself is made by updateSiteAndPolicies.
+func (a *ApplicationServiceImpl) updateApplication(ctx context.Context, application *model.Application, ids *applicationObjectIds, output *ApplicationReconcileOutput) error {
+
+	foundApplicationDTO, updatedApplicationDTO, err := a.completeApplication(ctx, application)
+	if err != nil {
+		if errors.Is(err, sac.ErrorNotFound) {
+			return a.recreateApplication(ctx, application, output)
+		}
+		return err
+	}
 
-	updatedApplicationDTO, err := a.completeApplication(application)
+	applicationDrift, err := dto.DiffApplication(foundApplicationDTO, updatedApplicationDTO)
 	if err != nil {
 		return err
 	}
+	bindingDrift, err := a.bindingDrift(ctx, application.ID, ids)
+	if err != nil {
+		return err
+	}
+	drift := append(applicationDrift, bindingDrift...)
+	if len(drift) == 0 {
+		return nil
+	}
+	if !application.SpecChanged {
+		if application.DriftPolicy == model.DriftPolicyReport {
+			output.Drift = drift
+			warningEvent(a.events, EventReasonDriftDetected, "application %s drifted in Secure-Access-Cloud: %s", application.ID, strings.Join(drift, ", "))
+			return nil
+		}
+		normalEvent(a.events, EventReasonDriftCorrected, "correcting application %s drift in Secure-Access-Cloud: %s", application.ID, strings.Join(drift, ", "))
+	}
+	if len(applicationDrift) == 0 {
+		return nil
+	}
 
-	_, err = a.sacClient.UpdateApplication(updatedApplicationDTO)
+	_, err = a.sacClient.UpdateApplication(ctx, updatedApplicationDTO)
 	if err != nil {
 		if errors.Is(err, sac.ErrorNotFound) {
 			return fmt.Errorf("%w application id %s not found", typederror.UnrecoverableError, application.ID)
 		}
-		return err
+		return sacError("update application "+application.ID, err)
 	}
+	normalEvent(a.events, EventReasonApplicationUpdated, "updated application %s in Secure-Access-Cloud", application.ID)
 
 	return nil
 }
 
-func (a *ApplicationServiceImpl) completeApplication(updatedApplication *model.Application) (*dto.ApplicationDTO, error) {
+// bindingDrift returns the drift of the binding of the application to its site and policies: DriftSite when the site
+// does not hold the application, DriftPolicies when one of the policies does not.
+func (a *ApplicationServiceImpl) bindingDrift(ctx context.Context, applicationID string, ids *applicationObjectIds) ([]string, error) {
+	var drift []string
+
+	site, err := a.sacClient.FindSiteByID(ctx, ids.siteId)
+	if err != nil {
+		return nil, sacError("get site "+ids.siteId, err)
+	}
+	if !containsID(site.ApplicationIDs, applicationID) {
+		drift = append(drift, model.DriftSite)
+	}
+
+	for _, policyID := range ids.policiesIds {
+		policy, err := a.sacClient.FindPolicyByID(ctx, policyID)
+		if err != nil {
+			return nil, sacError("get policy "+policyID, err)
+		}
+		var applicationsIDs []string
+		for _, policyApplication := range policy.Applications {
+			applicationsIDs = append(applicationsIDs, policyApplication.ID)
+		}
+		if !containsID(applicationsIDs, applicationID) {
+			drift = append(drift, model.DriftPolicies)
+			break
+		}
+	}
+
+	return drift, nil
+}
+
+func containsID(ids []string, id string) bool {
+	for i := range ids {
+		if ids[i] == id {
+			return true
+		}
+	}
+	return false
+}
+
+// recreateApplication handles an application which was deleted in Secure-Access-Cloud, outside the cluster. It is
+// created again unless the drift policy only reports it.
+func (a *ApplicationServiceImpl) recreateApplication(ctx context.Context, application *model.Application, output *ApplicationReconcileOutput) error {
+
+	if !application.SpecChanged && application.DriftPolicy == model.DriftPolicyReport {
+		output.Drift = []string{model.DriftDeleted}
+		warningEvent(a.events, EventReasonDriftDetected, "application %s was deleted in Secure-Access-Cloud", application.ID)
+		return nil
+	}
+
+	normalEvent(a.events, EventReasonDriftCorrected, "application %s was deleted in Secure-Access-Cloud, creating it again", application.ID)
+	application.ID = ""
+	return a.create(ctx, application)
+}
+
+// completeApplication returns the application found in Secure-Access-Cloud and the updated application.
+func (a *ApplicationServiceImpl) completeApplication(ctx context.Context, updatedApplication *model.Application) (*dto.ApplicationDTO, *dto.ApplicationDTO, error) {
 	// The application entity in SAC might contain additional attributes which are unknown or not related to this
 	// operator. Instead of sending the updated application received from the operator, this function first fetch the
 	// existing application in SAC and merge the updated application data to it in order not to override attributes
 	// which have been updated in SAC but is not related here.
-	foundApplicationDTO, err := a.sacClient.FindApplicationByID(updatedApplication.ID)
+	foundApplicationDTO, err := a.sacClient.FindApplicationByID(ctx, updatedApplication.ID)
 	if err != nil {
-		return nil, err
+		return nil, nil, sacError("get application "+updatedApplication.ID, err)
 	}
 
 	updatedApplicationDTO, err := dto.FromApplicationModel(updatedApplication)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	mergedApplicationDTO := dto.MergeApplication(foundApplicationDTO, updatedApplicationDTO, dto.MergeOptions{})
 
-	return mergedApplicationDTO, nil
+	return foundApplicationDTO, mergedApplicationDTO, nil
 }
 
-func (a *ApplicationServiceImpl) delete(id string) error {
+func (a *ApplicationServiceImpl) delete(ctx context.Context, id string) error {
 	a.log.Info("Deleting Application: '" + id + "'...")
 
-	err := a.sacClient.DeleteApplication(id)
+	err := a.sacClient.DeleteApplication(ctx, id)
+	if errors.Is(err, sac.ErrorNotFound) {
+		a.log.Info("Application: '" + id + "' was already deleted.")
+		return nil
+	}
 	if err != nil {
-		return err
+		return sacError("delete application "+id, err)
 	}
 
 	a.log.Info("Application: '" + id + "' deleted successfully.")
+	normalEvent(a.events, EventReasonApplicationDeleted, "deleted application %s from Secure-Access-Cloud", id)
 	return nil
 }
 
 func (a *ApplicationServiceImpl) bindSiteToApplication(
-	applicationID, siteID string,
+	ctx context.Context, applicationID, siteID string,
 ) error {
 	// Bind to SiteName (Idempotent)
-	err := a.sacClient.BindApplicationToSite(applicationID, siteID)
+	err := a.sacClient.BindApplicationToSite(ctx, applicationID, siteID)
 	if err != nil {
-		return err
+		return sacError("bind application "+applicationID+" to site", err)
 	}
 
 	return nil
 }
 
 func (a *ApplicationServiceImpl) bindPoliciesToApplication(
-	applicationID string, applicationType model.ApplicationType, policiesIDs []string,
+	ctx context.Context, applicationID string, applicationType model.ApplicationType, policiesIDs []string,
 ) error {
 
 	if len(policiesIDs) == 0 || policiesIDs == nil {
 		return nil
 	}
 
-	err := a.sacClient.UpdatePolicies(applicationID, applicationType, policiesIDs)
+	err := a.sacClient.UpdatePolicies(ctx, applicationID, applicationType, policiesIDs)
 	if err != nil {
-		return err
+		return sacError("bind application "+applicationID+" to policies", err)
 	}
 
 	return nil
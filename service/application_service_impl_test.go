@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"bitbucket.org/accezz-io/sac-operator/utils/typederror"
@@ -10,7 +12,9 @@ import (
 
 	"bitbucket.org/accezz-io/sac-operator/model"
 	"bitbucket.org/accezz-io/sac-operator/service/sac"
+	"bitbucket.org/accezz-io/sac-operator/service/sac/dto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -49,7 +53,7 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 			name: "[delete application flow] failed to delete",
 			setupFunc: func() (ApplicationService, *model.Application) {
 				sacClient := &sac.MockSecureAccessCloudClient{}
-				sacClient.On("DeleteApplication", mock.AnythingOfType("string")).Return(errorFromSacService)
+				sacClient.On("DeleteApplication", mock.Anything, mock.AnythingOfType("string")).Return(errorFromSacService)
 				testLog := ctrl.Log.WithName("test")
 				app := &model.Application{
 					ToDelete: true,
@@ -62,11 +66,42 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 			},
 			err: errorFromSacService,
 		},
+		{
+			name: "[delete application flow] already deleted",
+			setupFunc: func() (ApplicationService, *model.Application) {
+				sacClient := &sac.MockSecureAccessCloudClient{}
+				sacClient.On("DeleteApplication", mock.Anything, "uuid").Return(&sac.APIError{StatusCode: http.StatusNotFound})
+				testLog := ctrl.Log.WithName("test")
+				app := &model.Application{
+					ToDelete: true,
+					ID:       "uuid",
+				}
+				return NewApplicationServiceImpl(sacClient, testLog), app
+			},
+			output: &ApplicationReconcileOutput{
+				Deleted: true,
+			},
+		},
+		{
+			name: "[delete application flow] permission denied",
+			setupFunc: func() (ApplicationService, *model.Application) {
+				sacClient := &sac.MockSecureAccessCloudClient{}
+				sacClient.On("DeleteApplication", mock.Anything, "uuid").Return(&sac.APIError{StatusCode: http.StatusForbidden})
+				testLog := ctrl.Log.WithName("test")
+				app := &model.Application{
+					ToDelete: true,
+					ID:       "uuid",
+				}
+				return NewApplicationServiceImpl(sacClient, testLog), app
+			},
+			output: &ApplicationReconcileOutput{},
+			err:    typederror.UnrecoverableError,
+		},
 		{
 			name: "[delete application flow] success flow",
 			setupFunc: func() (ApplicationService, *model.Application) {
 				sacClient := &sac.MockSecureAccessCloudClient{}
-				sacClient.On("DeleteApplication", mock.AnythingOfType("string")).Return(nil)
+				sacClient.On("DeleteApplication", mock.Anything, mock.AnythingOfType("string")).Return(nil)
 				testLog := ctrl.Log.WithName("test")
 				app := &model.Application{
 					ToDelete: true,
@@ -90,6 +125,317 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 	}
 }
 
+func TestApplicationServiceImpl_getSiteAndPoliciesIDs_ResolvedPolicies(t *testing.T) {
+	// given
+	sacClient := &sac.MockSecureAccessCloudClient{}
+	sacClient.On("FindSiteByName", mock.Anything, "my-site").Return(&dto.SiteDTO{ID: "site-uuid"}, nil)
+	sacClient.On("FindPoliciesByNames", mock.Anything, []model.PolicyReference{{Type: model.AccessPolicy, Name: "portal-policy"}}).Return([]dto.PolicyDTO{{ID: "portal-policy-uuid"}}, nil)
+	applicationService := &ApplicationServiceImpl{sacClient: sacClient, log: ctrl.Log.WithName("test")}
+	application := &model.Application{
+		CommonApplicationParams: model.CommonApplicationParams{
+			SiteName:            "my-site",
+			AccessPoliciesNames: []string{"cluster-policy", "portal-policy"},
+			ResolvedPoliciesIDs: map[model.PolicyReference]string{{Type: model.AccessPolicy, Name: "cluster-policy"}: "cluster-policy-uuid"},
+		},
+	}
+
+	// when
+	ids, err := applicationService.getSiteAndPoliciesIDs(context.Background(), application)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, "site-uuid", ids.siteId)
+	assert.Equal(t, []string{"cluster-policy-uuid", "portal-policy-uuid"}, ids.policiesIds)
+}
+
+func TestApplicationServiceImpl_getSiteAndPoliciesIDs_PoliciesOfSameName(t *testing.T) {
+	// given an access and an activity policy named alike, only the access policy being managed in the cluster
+	sacClient := &sac.MockSecureAccessCloudClient{}
+	sacClient.On("FindSiteByName", mock.Anything, "my-site").Return(&dto.SiteDTO{ID: "site-uuid"}, nil)
+	sacClient.On("FindPoliciesByNames", mock.Anything, []model.PolicyReference{{Type: model.ActivityPolicy, Name: "devops"}}).Return([]dto.PolicyDTO{{ID: "activity-policy-uuid"}}, nil)
+	applicationService := &ApplicationServiceImpl{sacClient: sacClient, log: ctrl.Log.WithName("test")}
+	application := &model.Application{
+		CommonApplicationParams: model.CommonApplicationParams{
+			SiteName:              "my-site",
+			AccessPoliciesNames:   []string{"devops"},
+			ActivityPoliciesNames: []string{"devops"},
+			ResolvedPoliciesIDs:   map[model.PolicyReference]string{{Type: model.AccessPolicy, Name: "devops"}: "access-policy-uuid"},
+		},
+	}
+
+	// when
+	ids, err := applicationService.getSiteAndPoliciesIDs(context.Background(), application)
+
+	// then the activity policy is looked up by its type and name
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"access-policy-uuid", "activity-policy-uuid"}, ids.policiesIds)
+	sacClient.AssertExpectations(t)
+}
+
+func TestApplicationServiceImpl_updateSiteAndPolicies(t *testing.T) {
+	// given
+	errorFromSacService := typederror.UnknownError
+	sacClient := &sac.MockSecureAccessCloudClient{}
+	sacClient.On("BindApplicationToSite", mock.Anything, "uuid", "site-uuid").Return(nil)
+	sacClient.On("UpdatePolicies", mock.Anything, "uuid", model.ApplicationType(model.HTTP), []string{"policy-uuid"}).Return(errorFromSacService)
+	events := &fakeEventRecorder{}
+	applicationService := NewApplicationServiceImpl(sacClient, ctrl.Log.WithName("test")).SetEventRecorder(events)
+	application := &model.Application{ID: "uuid", Type: model.HTTP, CommonApplicationParams: model.CommonApplicationParams{SiteName: "my-site"}}
+	ids := &applicationObjectIds{siteId: "site-uuid", policiesIds: []string{"policy-uuid"}}
+	output := &ApplicationReconcileOutput{}
+
+	// when
+	err := applicationService.updateSiteAndPolicies(context.Background(), application, ids, true, output)
+
+	// then
+	assert.ErrorIs(t, err, errorFromSacService)
+	assert.True(t, output.SiteBound)
+	assert.False(t, output.PoliciesBound)
+	assert.Equal(t, []string{"Normal SiteBound bound application to site my-site"}, events.events)
+}
+
+func TestApplicationServiceImpl_getSiteAndPoliciesIDs_SiteNotFound(t *testing.T) {
+	// given
+	sacClient := &sac.MockSecureAccessCloudClient{}
+	sacClient.On("FindSiteByName", mock.Anything, "my-site").Return(&dto.SiteDTO{}, sac.ErrorNotFound)
+	events := &fakeEventRecorder{}
+	applicationService := NewApplicationServiceImpl(sacClient, ctrl.Log.WithName("test")).SetEventRecorder(events)
+	application := &model.Application{CommonApplicationParams: model.CommonApplicationParams{SiteName: "my-site"}}
+
+	// when
+	_, err := applicationService.getSiteAndPoliciesIDs(context.Background(), application)
+
+	// then
+	assert.ErrorIs(t, err, typederror.UnrecoverableError)
+	assert.Equal(t, []string{"Warning SiteNotFound site my-site does not exist"}, events.events)
+}
+
+func TestApplicationServiceImpl_getSiteAndPoliciesIDs_AmbiguousSite(t *testing.T) {
+	// given
+	sacClient := &sac.MockSecureAccessCloudClient{}
+	sacClient.On("FindSiteByName", mock.Anything, "my-site").Return(&dto.SiteDTO{}, fmt.Errorf("%w: 2 sites are named my-site", sac.ErrorAmbiguousName))
+	events := &fakeEventRecorder{}
+	applicationService := NewApplicationServiceImpl(sacClient, ctrl.Log.WithName("test")).SetEventRecorder(events)
+	application := &model.Application{CommonApplicationParams: model.CommonApplicationParams{SiteName: "my-site"}}
+
+	// when
+	_, err := applicationService.getSiteAndPoliciesIDs(context.Background(), application)
+
+	// then
+	assert.ErrorIs(t, err, typederror.UnrecoverableError)
+	assert.Equal(t, []string{"Warning AmbiguousName cannot resolve site: ambiguous name: 2 sites are named my-site"}, events.events)
+}
+
+func TestApplicationServiceImpl_updateApplication_Drift(t *testing.T) {
+	tests := []struct {
+		name          string
+		specChanged   bool
+		driftPolicy   model.DriftPolicy
+		editInPortal  func(application *dto.ApplicationDTO)
+		siteApps      []string
+		expectUpdate  bool
+		expectedDrift []string
+	}{
+		{
+			name:         "no drift",
+			driftPolicy:  model.DriftPolicyReport,
+			editInPortal: func(application *dto.ApplicationDTO) {},
+		},
+		{
+			name:        "drift is corrected",
+			driftPolicy: model.DriftPolicyCorrect,
+			editInPortal: func(application *dto.ApplicationDTO) {
+				application.ConnectionSettings.InternalAddress = "http://changed-in-portal:80"
+			},
+			expectUpdate: true,
+		},
+		{
+			name:        "drift is reported",
+			driftPolicy: model.DriftPolicyReport,
+			editInPortal: func(application *dto.ApplicationDTO) {
+				application.ConnectionSettings.InternalAddress = "http://changed-in-portal:80"
+			},
+			expectedDrift: []string{"connectionSettings.internalAddress"},
+		},
+		{
+			name:          "disabled in the portal",
+			driftPolicy:   model.DriftPolicyReport,
+			editInPortal:  func(application *dto.ApplicationDTO) { application.Enabled = false },
+			expectedDrift: []string{"enabled"},
+		},
+		{
+			name:          "subdomain changed in the portal",
+			driftPolicy:   model.DriftPolicyReport,
+			editInPortal:  func(application *dto.ApplicationDTO) { application.ConnectionSettings.Subdomain = "changed-in-portal" },
+			expectedDrift: []string{"connectionSettings.subdomain"},
+		},
+		{
+			name:        "header customization removed in the portal",
+			driftPolicy: model.DriftPolicyReport,
+			editInPortal: func(application *dto.ApplicationDTO) {
+				application.HttpRequestCustomizationSettings = nil
+			},
+			expectedDrift: []string{"requestCustomizationSettings"},
+		},
+		{
+			name:          "unbound from its site in the portal",
+			driftPolicy:   model.DriftPolicyReport,
+			editInPortal:  func(application *dto.ApplicationDTO) {},
+			siteApps:      []string{"other-uuid"},
+			expectedDrift: []string{model.DriftSite},
+		},
+		{
+			name:         "binding drift is corrected by the binding only",
+			driftPolicy:  model.DriftPolicyCorrect,
+			editInPortal: func(application *dto.ApplicationDTO) {},
+			siteApps:     []string{"other-uuid"},
+		},
+		{
+			name:        "spec change is applied when drift is reported",
+			specChanged: true,
+			driftPolicy: model.DriftPolicyReport,
+			editInPortal: func(application *dto.ApplicationDTO) {
+				application.ConnectionSettings.InternalAddress = "http://changed-in-portal:80"
+			},
+			expectUpdate: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given an application edited in the portal
+			application := &model.Application{
+				ID:          "uuid",
+				Type:        model.HTTP,
+				SpecChanged: tt.specChanged,
+				CommonApplicationParams: model.CommonApplicationParams{
+					Name:        "my-app",
+					Enabled:     true,
+					DriftPolicy: tt.driftPolicy,
+				},
+				ConnectionSettings: &model.ConnectionSettings{InternalAddress: "http://my-service.default:80", Subdomain: "my-app"},
+				HttpRequestCustomizationSettings: &model.HttpRequestCustomizationSettings{
+					HeaderCustomization: map[string]string{"X-Tenant": "my-tenant"},
+				},
+			}
+			foundApplication, err := dto.FromApplicationModel(application)
+			require.NoError(t, err)
+			tt.editInPortal(foundApplication)
+			siteApps := tt.siteApps
+			if siteApps == nil {
+				siteApps = []string{"uuid"}
+			}
+			sacClient := &sac.MockSecureAccessCloudClient{}
+			sacClient.On("FindApplicationByID", mock.Anything, "uuid").Return(foundApplication, nil)
+			sacClient.On("FindSiteByID", mock.Anything, "site-uuid").Return(&dto.SiteDTO{ID: "site-uuid", ApplicationIDs: siteApps}, nil)
+			sacClient.On("UpdateApplication", mock.Anything, mock.Anything).Return(&dto.ApplicationDTO{}, nil)
+			applicationService := NewApplicationServiceImpl(sacClient, ctrl.Log.WithName("test"))
+			ids := &applicationObjectIds{siteId: "site-uuid"}
+			output := &ApplicationReconcileOutput{}
+
+			// when
+			err = applicationService.updateApplication(context.Background(), application, ids, output)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedDrift, output.Drift)
+			if tt.expectUpdate {
+				sacClient.AssertCalled(t, "UpdateApplication", mock.Anything, mock.Anything)
+			} else {
+				sacClient.AssertNotCalled(t, "UpdateApplication", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestApplicationServiceImpl_bindingDrift_Policies(t *testing.T) {
+	// given a policy from which the application was removed in the portal
+	sacClient := &sac.MockSecureAccessCloudClient{}
+	sacClient.On("FindSiteByID", mock.Anything, "site-uuid").Return(&dto.SiteDTO{ID: "site-uuid", ApplicationIDs: []string{"uuid"}}, nil)
+	sacClient.On("FindPolicyByID", mock.Anything, "bound-policy-uuid").Return(&dto.PolicyDTO{
+		ID: "bound-policy-uuid",
+		Applications: []struct {
+			ID   string `json:"id"`
+			Type string `json:"type"`
+		}{{ID: "uuid", Type: "HTTP"}},
+	}, nil)
+	sacClient.On("FindPolicyByID", mock.Anything, "unbound-policy-uuid").Return(&dto.PolicyDTO{ID: "unbound-policy-uuid"}, nil)
+	applicationService := NewApplicationServiceImpl(sacClient, ctrl.Log.WithName("test"))
+	ids := &applicationObjectIds{siteId: "site-uuid", policiesIds: []string{"bound-policy-uuid", "unbound-policy-uuid"}}
+
+	// when
+	drift, err := applicationService.bindingDrift(context.Background(), "uuid", ids)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, []string{model.DriftPolicies}, drift)
+}
+
+func TestApplicationServiceImpl_Reconcile_BindingEvents(t *testing.T) {
+	tests := []struct {
+		name           string
+		specChanged    bool
+		expectedEvents []string
+	}{
+		{
+			name: "resync",
+		},
+		{
+			name:        "spec changed",
+			specChanged: true,
+			expectedEvents: []string{
+				"Normal SiteBound bound application to site my-site",
+				"Normal PoliciesBound bound application to 1 policies",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given an application which did not drift
+			sacClient := &sac.MockSecureAccessCloudClient{}
+			sacClient.On("FindSiteByName", mock.Anything, "my-site").Return(&dto.SiteDTO{ID: "site-uuid"}, nil)
+			sacClient.On("FindPoliciesByNames", mock.Anything, []model.PolicyReference{{Type: model.AccessPolicy, Name: "my-policy"}}).Return([]dto.PolicyDTO{{ID: "policy-uuid"}}, nil)
+			sacClient.On("FindApplicationByID", mock.Anything, "uuid").Return(&dto.ApplicationDTO{ID: "uuid", Name: "my-app", Type: model.HTTP}, nil)
+			sacClient.On("FindSiteByID", mock.Anything, "site-uuid").Return(&dto.SiteDTO{ID: "site-uuid", ApplicationIDs: []string{"uuid"}}, nil)
+			sacClient.On("FindPolicyByID", mock.Anything, "policy-uuid").Return(&dto.PolicyDTO{ID: "policy-uuid", Applications: []struct {
+				ID   string `json:"id"`
+				Type string `json:"type"`
+			}{{ID: "uuid", Type: "HTTP"}}}, nil)
+			sacClient.On("BindApplicationToSite", mock.Anything, "uuid", "site-uuid").Return(nil)
+			sacClient.On("UpdatePolicies", mock.Anything, "uuid", model.ApplicationType(model.HTTP), []string{"policy-uuid"}).Return(nil)
+			events := &fakeEventRecorder{}
+			applicationService := NewApplicationServiceImpl(sacClient, ctrl.Log.WithName("test")).SetEventRecorder(events)
+			application := &model.Application{
+				ID:          "uuid",
+				Type:        model.HTTP,
+				SpecChanged: tt.specChanged,
+				CommonApplicationParams: model.CommonApplicationParams{
+					Name:                "my-app",
+					SiteName:            "my-site",
+					AccessPoliciesNames: []string{"my-policy"},
+				},
+				ConnectionSettings: &model.ConnectionSettings{},
+			}
+
+			// when
+			output, err := applicationService.Reconcile(context.Background(), application)
+
+			// then the binding is made, and announced only when it changed
+			assert.NoError(t, err)
+			assert.True(t, output.SiteBound)
+			assert.True(t, output.PoliciesBound)
+			assert.Equal(t, tt.expectedEvents, events.events)
+		})
+	}
+}
+
+type fakeEventRecorder struct {
+	events []string
+}
+
+func (f *fakeEventRecorder) Eventf(eventType, reason, messageFmt string, args ...interface{}) {
+	f.events = append(f.events, eventType+" "+reason+" "+fmt.Sprintf(messageFmt, args...))
+}
+
 //func TestApplicationServiceImpl_Reconcile_GetIDs(t *testing.T) {
 //	errorFromSacService := typederror.UnknownError
 //	tests := []struct {
@@ -104,8 +450,8 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //				appName := "test-application"
 //				siteToUse := "test-site"
 //				sacClient := &sac.MockSecureAccessCloudClient{}
-//				sacClient.On("FindApplicationByName", appName).Return(&dto.ApplicationDTO{}, sac.ErrorNotFound)
-//				sacClient.On("FindSiteByName", siteToUse).Return(&dto.SiteDTO{}, sac.ErrorNotFound)
+//				sacClient.On("FindApplicationByName", mock.Anything, appName).Return(&dto.ApplicationDTO{}, sac.ErrorNotFound)
+//				sacClient.On("FindSiteByName", mock.Anything, siteToUse).Return(&dto.SiteDTO{}, sac.ErrorNotFound)
 //				testLog := ctrl.Log.WithName("test")
 //				app := &model.Application{
 //					Name:     appName,
@@ -122,8 +468,8 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //				appName := "test-application"
 //				siteToUse := "test-site"
 //				sacClient := &sac.MockSecureAccessCloudClient{}
-//				sacClient.On("FindApplicationByName", appName).Return(&dto.ApplicationDTO{}, sac.ErrorNotFound)
-//				sacClient.On("FindSiteByName", siteToUse).Return(&dto.SiteDTO{}, errorFromSacService)
+//				sacClient.On("FindApplicationByName", mock.Anything, appName).Return(&dto.ApplicationDTO{}, sac.ErrorNotFound)
+//				sacClient.On("FindSiteByName", mock.Anything, siteToUse).Return(&dto.SiteDTO{}, errorFromSacService)
 //				testLog := ctrl.Log.WithName("test")
 //				app := &model.Application{
 //					Name:     appName,
@@ -141,9 +487,9 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //				siteToUse := "test-site"
 //				accessPolicy := []string{"1", "2"}
 //				sacClient := &sac.MockSecureAccessCloudClient{}
-//				sacClient.On("FindApplicationByName", appName).Return(&dto.ApplicationDTO{}, sac.ErrorNotFound)
-//				sacClient.On("FindSiteByName", siteToUse).Return(&dto.SiteDTO{}, nil)
-//				sacClient.On("FindPoliciesByNames", accessPolicy).Return([]dto.PolicyDTO{}, sac.ErrorNotFound)
+//				sacClient.On("FindApplicationByName", mock.Anything, appName).Return(&dto.ApplicationDTO{}, sac.ErrorNotFound)
+//				sacClient.On("FindSiteByName", mock.Anything, siteToUse).Return(&dto.SiteDTO{}, nil)
+//				sacClient.On("FindPoliciesByNames", mock.Anything, accessPolicy).Return([]dto.PolicyDTO{}, sac.ErrorNotFound)
 //				testLog := ctrl.Log.WithName("test")
 //				app := &model.Application{
 //					Name:                appName,
@@ -162,9 +508,9 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //				siteToUse := "test-site"
 //				accessPolicy := []string{"1", "2"}
 //				sacClient := &sac.MockSecureAccessCloudClient{}
-//				sacClient.On("FindApplicationByName", appName).Return(&dto.ApplicationDTO{}, sac.ErrorNotFound)
-//				sacClient.On("FindSiteByName", siteToUse).Return(&dto.SiteDTO{}, nil)
-//				sacClient.On("FindPoliciesByNames", accessPolicy).Return([]dto.PolicyDTO{}, errorFromSacService)
+//				sacClient.On("FindApplicationByName", mock.Anything, appName).Return(&dto.ApplicationDTO{}, sac.ErrorNotFound)
+//				sacClient.On("FindSiteByName", mock.Anything, siteToUse).Return(&dto.SiteDTO{}, nil)
+//				sacClient.On("FindPoliciesByNames", mock.Anything, accessPolicy).Return([]dto.PolicyDTO{}, errorFromSacService)
 //				testLog := ctrl.Log.WithName("test")
 //				app := &model.Application{
 //					Name:                appName,
@@ -202,11 +548,11 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //				siteToUse := "test-site"
 //				accessPolicy := []string{"1", "2"}
 //				sacClient := &sac.MockSecureAccessCloudClient{}
-//				sacClient.On("FindApplicationByName", appName).Return(&dto.ApplicationDTO{
+//				sacClient.On("FindApplicationByName", mock.Anything, appName).Return(&dto.ApplicationDTO{
 //					ID: "uuid",
 //				}, nil)
-//				sacClient.On("FindSiteByName", siteToUse).Return(&dto.SiteDTO{ID: "siteUUID"}, nil)
-//				sacClient.On("FindPoliciesByNames", accessPolicy).Return([]dto.PolicyDTO{
+//				sacClient.On("FindSiteByName", mock.Anything, siteToUse).Return(&dto.SiteDTO{ID: "siteUUID"}, nil)
+//				sacClient.On("FindPoliciesByNames", mock.Anything, accessPolicy).Return([]dto.PolicyDTO{
 //					{ID: "policy-uuid-1"},
 //					{ID: "policy-uuid-2"},
 //				}, nil)
@@ -217,7 +563,7 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //					AccessPoliciesNames: accessPolicy,
 //					Type:                model.HTTP,
 //				}
-//				sacClient.On("CreateApplication", &dto.ApplicationDTO{
+//				sacClient.On("CreateApplication", mock.Anything, &dto.ApplicationDTO{
 //					Name: appName,
 //					Type: model.HTTP,
 //				}).Return(&dto.ApplicationDTO{
@@ -239,9 +585,9 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //				siteToUse := "test-site"
 //				accessPolicy := []string{"1", "2"}
 //				sacClient := &sac.MockSecureAccessCloudClient{}
-//				sacClient.On("FindApplicationByName", appName).Return(&dto.ApplicationDTO{}, errorFromSacService)
-//				sacClient.On("FindSiteByName", siteToUse).Return(&dto.SiteDTO{ID: "siteUUID"}, nil)
-//				sacClient.On("FindPoliciesByNames", accessPolicy).Return([]dto.PolicyDTO{
+//				sacClient.On("FindApplicationByName", mock.Anything, appName).Return(&dto.ApplicationDTO{}, errorFromSacService)
+//				sacClient.On("FindSiteByName", mock.Anything, siteToUse).Return(&dto.SiteDTO{ID: "siteUUID"}, nil)
+//				sacClient.On("FindPoliciesByNames", mock.Anything, accessPolicy).Return([]dto.PolicyDTO{
 //					{ID: "policy-uuid-1"},
 //					{ID: "policy-uuid-2"},
 //				}, nil)
@@ -267,9 +613,9 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //				siteToUse := "test-site"
 //				accessPolicy := []string{"1", "2"}
 //				sacClient := &sac.MockSecureAccessCloudClient{}
-//				sacClient.On("FindApplicationByName", appName).Return(&dto.ApplicationDTO{}, sac.ErrorNotFound)
-//				sacClient.On("FindSiteByName", siteToUse).Return(&dto.SiteDTO{ID: "siteUUID"}, nil)
-//				sacClient.On("FindPoliciesByNames", accessPolicy).Return([]dto.PolicyDTO{
+//				sacClient.On("FindApplicationByName", mock.Anything, appName).Return(&dto.ApplicationDTO{}, sac.ErrorNotFound)
+//				sacClient.On("FindSiteByName", mock.Anything, siteToUse).Return(&dto.SiteDTO{ID: "siteUUID"}, nil)
+//				sacClient.On("FindPoliciesByNames", mock.Anything, accessPolicy).Return([]dto.PolicyDTO{
 //					{ID: "policy-uuid-1"},
 //					{ID: "policy-uuid-2"},
 //				}, nil)
@@ -280,7 +626,7 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //					AccessPoliciesNames: accessPolicy,
 //					Type:                model.HTTP,
 //				}
-//				sacClient.On("CreateApplication", &dto.ApplicationDTO{
+//				sacClient.On("CreateApplication", mock.Anything, &dto.ApplicationDTO{
 //					Name: appName,
 //					Type: model.HTTP,
 //				}).Return(&dto.ApplicationDTO{
@@ -302,9 +648,9 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //				siteToUse := "test-site"
 //				accessPolicy := []string{"1", "2"}
 //				sacClient := &sac.MockSecureAccessCloudClient{}
-//				sacClient.On("FindApplicationByName", appName).Return(&dto.ApplicationDTO{}, sac.ErrorNotFound)
-//				sacClient.On("FindSiteByName", siteToUse).Return(&dto.SiteDTO{ID: "site-uuid"}, nil)
-//				sacClient.On("FindPoliciesByNames", accessPolicy).Return([]dto.PolicyDTO{
+//				sacClient.On("FindApplicationByName", mock.Anything, appName).Return(&dto.ApplicationDTO{}, sac.ErrorNotFound)
+//				sacClient.On("FindSiteByName", mock.Anything, siteToUse).Return(&dto.SiteDTO{ID: "site-uuid"}, nil)
+//				sacClient.On("FindPoliciesByNames", mock.Anything, accessPolicy).Return([]dto.PolicyDTO{
 //					{ID: "policy-uuid-1"},
 //					{ID: "policy-uuid-2"},
 //				}, nil)
@@ -315,15 +661,15 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //					AccessPoliciesNames: accessPolicy,
 //					Type:                model.HTTP,
 //				}
-//				sacClient.On("CreateApplication", &dto.ApplicationDTO{
+//				sacClient.On("CreateApplication", mock.Anything, &dto.ApplicationDTO{
 //					Name: appName,
 //					Type: model.HTTP,
 //				}).Return(&dto.ApplicationDTO{
 //					ID:   "uuid",
 //					Type: model.HTTP,
 //				}, nil)
-//				sacClient.On("BindApplicationToSite", "uuid", "site-uuid").Return(nil)
-//				sacClient.On("UpdatePolicies", "uuid", model.ApplicationType("HTTP"), []string{"policy-uuid-1", "policy-uuid-2"}).Return(nil)
+//				sacClient.On("BindApplicationToSite", mock.Anything, "uuid", "site-uuid").Return(nil)
+//				sacClient.On("UpdatePolicies", mock.Anything, "uuid", model.ApplicationType("HTTP"), []string{"policy-uuid-1", "policy-uuid-2"}).Return(nil)
 //				return NewApplicationServiceImpl(sacClient, testLog), app
 //			},
 //			output: &ApplicationReconcileOutput{
@@ -362,8 +708,8 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //				accessPolicy := []string{"access-policy-name-1", "access-policy-name-2"}
 //				activityPolicy := []string{"activity-policy-name-1", "activity-policy-name-2"}
 //				sacClient := &sac.MockSecureAccessCloudClient{}
-//				sacClient.On("FindSiteByName", siteToUse).Return(&dto.SiteDTO{ID: "siteUUID"}, nil)
-//				sacClient.On("FindPoliciesByNames", append(accessPolicy, activityPolicy...)).Return([]dto.PolicyDTO{
+//				sacClient.On("FindSiteByName", mock.Anything, siteToUse).Return(&dto.SiteDTO{ID: "siteUUID"}, nil)
+//				sacClient.On("FindPoliciesByNames", mock.Anything, append(accessPolicy, activityPolicy...)).Return([]dto.PolicyDTO{
 //					{ID: "policy-uuid-1"},
 //					{ID: "policy-uuid-2"},
 //				}, nil)
@@ -380,7 +726,7 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //					PoliciesIDS:           nil,
 //					ToDelete:              false,
 //				}
-//				sacClient.On("UpdateApplication", &dto.ApplicationDTO{
+//				sacClient.On("UpdateApplication", mock.Anything, &dto.ApplicationDTO{
 //					ID:      "uuid",
 //					Name:    appName,
 //					Type:    model.HTTP,
@@ -411,8 +757,8 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //				accessPolicy := []string{"access-policy-name-1", "access-policy-name-2"}
 //				activityPolicy := []string{"activity-policy-name-1", "activity-policy-name-2"}
 //				sacClient := &sac.MockSecureAccessCloudClient{}
-//				sacClient.On("FindSiteByName", siteToUse).Return(&dto.SiteDTO{ID: siteID}, nil)
-//				sacClient.On("FindPoliciesByNames", append(accessPolicy, activityPolicy...)).Return([]dto.PolicyDTO{
+//				sacClient.On("FindSiteByName", mock.Anything, siteToUse).Return(&dto.SiteDTO{ID: siteID}, nil)
+//				sacClient.On("FindPoliciesByNames", mock.Anything, append(accessPolicy, activityPolicy...)).Return([]dto.PolicyDTO{
 //					{ID: "policy-uuid-1"},
 //					{ID: "policy-uuid-2"},
 //				}, nil)
@@ -429,7 +775,7 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //					PoliciesIDS:           nil,
 //					ToDelete:              false,
 //				}
-//				sacClient.On("UpdateApplication", &dto.ApplicationDTO{
+//				sacClient.On("UpdateApplication", mock.Anything, &dto.ApplicationDTO{
 //					ID:      "uuid",
 //					Name:    appName,
 //					Type:    model.HTTP,
@@ -443,7 +789,7 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //					IsNotificationEnabled: false,
 //					Enabled:               false,
 //				}).Return(&dto.ApplicationDTO{}, nil)
-//				sacClient.On("BindApplicationToSite", app.ID, app.SiteId).Return(errorFromSacService)
+//				sacClient.On("BindApplicationToSite", mock.Anything, app.ID, app.SiteId).Return(errorFromSacService)
 //				return NewApplicationServiceImpl(sacClient, testLog), app
 //			},
 //			expectedOutput: &ApplicationReconcileOutput{
@@ -461,8 +807,8 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //				accessPolicy := []string{"access-policy-name-1", "access-policy-name-2"}
 //				activityPolicy := []string{"activity-policy-name-1", "activity-policy-name-2"}
 //				sacClient := &sac.MockSecureAccessCloudClient{}
-//				sacClient.On("FindSiteByName", siteToUse).Return(&dto.SiteDTO{ID: siteID}, nil)
-//				sacClient.On("FindPoliciesByNames", append(accessPolicy, activityPolicy...)).Return([]dto.PolicyDTO{
+//				sacClient.On("FindSiteByName", mock.Anything, siteToUse).Return(&dto.SiteDTO{ID: siteID}, nil)
+//				sacClient.On("FindPoliciesByNames", mock.Anything, append(accessPolicy, activityPolicy...)).Return([]dto.PolicyDTO{
 //					{ID: "access-policy-uuid-1"},
 //					{ID: "access-policy-uuid-2"},
 //					{ID: "activity-policy-uuid-1"},
@@ -481,7 +827,7 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //					PoliciesIDS:           nil,
 //					ToDelete:              false,
 //				}
-//				sacClient.On("UpdateApplication", &dto.ApplicationDTO{
+//				sacClient.On("UpdateApplication", mock.Anything, &dto.ApplicationDTO{
 //					ID:      "uuid",
 //					Name:    appName,
 //					Type:    model.HTTP,
@@ -495,8 +841,8 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //					IsNotificationEnabled: false,
 //					Enabled:               false,
 //				}).Return(&dto.ApplicationDTO{}, nil)
-//				sacClient.On("BindApplicationToSite", app.ID, app.SiteId).Return(nil)
-//				sacClient.On("UpdatePolicies", app.ID, model.ApplicationType("HTTP"), []string{
+//				sacClient.On("BindApplicationToSite", mock.Anything, app.ID, app.SiteId).Return(nil)
+//				sacClient.On("UpdatePolicies", mock.Anything, app.ID, model.ApplicationType("HTTP"), []string{
 //					"access-policy-uuid-1",
 //					"access-policy-uuid-2",
 //					"activity-policy-uuid-1",
@@ -519,8 +865,8 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //				accessPolicy := []string{"access-policy-name-1", "access-policy-name-2"}
 //				activityPolicy := []string{"activity-policy-name-1", "activity-policy-name-2"}
 //				sacClient := &sac.MockSecureAccessCloudClient{}
-//				sacClient.On("FindSiteByName", siteToUse).Return(&dto.SiteDTO{ID: siteID}, nil)
-//				sacClient.On("FindPoliciesByNames", append(accessPolicy, activityPolicy...)).Return([]dto.PolicyDTO{
+//				sacClient.On("FindSiteByName", mock.Anything, siteToUse).Return(&dto.SiteDTO{ID: siteID}, nil)
+//				sacClient.On("FindPoliciesByNames", mock.Anything, append(accessPolicy, activityPolicy...)).Return([]dto.PolicyDTO{
 //					{ID: "access-policy-uuid-1"},
 //					{ID: "access-policy-uuid-2"},
 //					{ID: "activity-policy-uuid-1"},
@@ -539,7 +885,7 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //					PoliciesIDS:           nil,
 //					ToDelete:              false,
 //				}
-//				sacClient.On("UpdateApplication", &dto.ApplicationDTO{
+//				sacClient.On("UpdateApplication", mock.Anything, &dto.ApplicationDTO{
 //					ID:      "uuid",
 //					Name:    appName,
 //					Type:    model.HTTP,
@@ -553,8 +899,8 @@ func TestApplicationServiceImpl_Reconcile_DeleteApplication(t *testing.T) {
 //					IsNotificationEnabled: false,
 //					Enabled:               false,
 //				}).Return(&dto.ApplicationDTO{}, nil)
-//				sacClient.On("BindApplicationToSite", app.ID, app.SiteId).Return(nil)
-//				sacClient.On("UpdatePolicies", app.ID, model.ApplicationType("HTTP"), []string{
+//				sacClient.On("BindApplicationToSite", mock.Anything, app.ID, app.SiteId).Return(nil)
+//				sacClient.On("UpdatePolicies", mock.Anything, app.ID, model.ApplicationType("HTTP"), []string{
 //					"access-policy-uuid-1",
 //					"access-policy-uuid-2",
 //					"activity-policy-uuid-1",
@@ -0,0 +1,46 @@
+package service
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// The reasons of the events recorded by the services.
+const (
+	EventReasonApplicationCreated = "ApplicationCreated"
+	EventReasonApplicationUpdated = "ApplicationUpdated"
+	EventReasonApplicationDeleted = "ApplicationDeleted"
+	EventReasonSiteBound          = "SiteBound"
+	EventReasonPoliciesBound      = "PoliciesBound"
+	EventReasonSiteNotFound       = "SiteNotFound"
+	EventReasonPolicyNotFound     = "PolicyNotFound"
+	EventReasonSiteCreated        = "SiteCreated"
+	EventReasonSiteDeleted        = "SiteDeleted"
+	EventReasonConnectorCreated   = "ConnectorCreated"
+	EventReasonConnectorDeleted   = "ConnectorDeleted"
+	EventReasonPolicyCreated      = "PolicyCreated"
+	EventReasonPolicyUpdated      = "PolicyUpdated"
+	EventReasonPolicyDeleted      = "PolicyDeleted"
+	EventReasonAlreadyExists      = "AlreadyExists"
+	EventReasonAmbiguousName      = "AmbiguousName"
+	EventReasonDriftDetected      = "DriftDetected"
+	EventReasonDriftCorrected     = "DriftCorrected"
+)
+
+// EventRecorder records the actions taken by the services in Secure-Access-Cloud, and their failures, as Kubernetes
+// events of the reconciled object.
+type EventRecorder interface {
+	Eventf(eventType, reason, messageFmt string, args ...interface{})
+}
+
+// noopEventRecorder is the EventRecorder of the services which were not given one.
+type noopEventRecorder struct{}
+
+func (n noopEventRecorder) Eventf(eventType, reason, messageFmt string, args ...interface{}) {}
+
+func normalEvent(recorder EventRecorder, reason, messageFmt string, args ...interface{}) {
+	recorder.Eventf(corev1.EventTypeNormal, reason, messageFmt, args...)
+}
+
+func warningEvent(recorder EventRecorder, reason, messageFmt string, args ...interface{}) {
+	recorder.Eventf(corev1.EventTypeWarning, reason, messageFmt, args...)
+}
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+
+	"bitbucket.org/accezz-io/sac-operator/model"
+)
+
+type PolicyService interface {
+	Reconcile(ctx context.Context, policy *model.Policy) (*PolicyReconcileOutput, error)
+}
+
+type PolicyReconcileOutput struct {
+	Deleted     bool
+	SACPolicyID string
+}
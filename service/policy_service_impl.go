@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	"github.com/pkg/errors"
+
+	"bitbucket.org/accezz-io/sac-operator/model"
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
+	"bitbucket.org/accezz-io/sac-operator/service/sac/dto"
+	"bitbucket.org/accezz-io/sac-operator/utils/typederror"
+)
+
+type PolicyServiceImpl struct {
+	sacClient sac.SecureAccessCloudClient
+	events    EventRecorder
+	log       logr.Logger
+}
+
+func NewPolicyServiceImpl(sacClient sac.SecureAccessCloudClient, logger logr.Logger) *PolicyServiceImpl {
+	return &PolicyServiceImpl{sacClient: sacClient, events: noopEventRecorder{}, log: logger}
+}
+
+// SetEventRecorder sets the recorder of the events about the reconciled policy.
+func (p *PolicyServiceImpl) SetEventRecorder(events EventRecorder) *PolicyServiceImpl {
+	p.events = events
+	return p
+}
+
+func (p *PolicyServiceImpl) Reconcile(ctx context.Context, policy *model.Policy) (*PolicyReconcileOutput, error) {
+
+	output := &PolicyReconcileOutput{}
+
+	if policy == nil {
+		return output, fmt.Errorf("policy cannot be nil, %w", typederror.UnrecoverableError)
+	}
+
+	if policy.ToDelete {
+		ctx = sac.WithPriority(ctx, sac.PriorityHigh)
+		if policy.ID == "" {
+			return output, fmt.Errorf("policy ID is nil, %w", typederror.UnrecoverableError)
+		}
+		err := p.delete(ctx, policy.ID)
+		if err != nil {
+			return output, err
+		}
+		output.Deleted = true
+		return output, nil
+	}
+
+	if policy.ID == "" {
+		err := p.create(ctx, policy)
+		if err != nil {
+			return output, err
+		}
+	} else {
+		err := p.update(ctx, policy)
+		if err != nil {
+			output.SACPolicyID = policy.ID
+			return output, err
+		}
+	}
+
+	output.SACPolicyID = policy.ID
+
+	return output, nil
+}
+
+func (p *PolicyServiceImpl) create(ctx context.Context, policyToCreate *model.Policy) error {
+	p.log.Info("creating policy: " + policyToCreate.String())
+
+	// 1. Find Policy by Type and Name to verify the name isn't used
+	policyInSac, err := p.sacClient.FindPolicyByName(ctx, policyToCreate.Type, policyToCreate.Name)
+	if errors.Is(err, sac.ErrorAmbiguousName) {
+		warningEvent(p.events, EventReasonAlreadyExists, "policy %s already exists in Secure-Access-Cloud: %s", policyToCreate.Name, err)
+		return fmt.Errorf("%w policy %s already exist: %s", typederror.UnrecoverableError, policyToCreate.Name, err)
+	}
+	if err != nil && !errors.Is(err, sac.ErrorNotFound) {
+		return sacError("validate policy "+policyToCreate.Name, err)
+	}
+
+	if policyInSac.ID != "" {
+		warningEvent(p.events, EventReasonAlreadyExists, "policy %s already exists in Secure-Access-Cloud", policyToCreate.Name)
+		return fmt.Errorf("%w policy %s already exist %s", typederror.UnrecoverableError, policyToCreate.Name, policyInSac.ID)
+	}
+
+	// 2. Create Policy
+	createdPolicyDTO, err := p.sacClient.CreatePolicy(ctx, dto.FromPolicyModel(policyToCreate))
+	if err != nil {
+		return sacError("create policy "+policyToCreate.Name, err)
+	}
+	policyToCreate.ID = createdPolicyDTO.ID
+	normalEvent(p.events, EventReasonPolicyCreated, "created policy %s in Secure-Access-Cloud", policyToCreate.ID)
+
+	return nil
+}
+
+func (p *PolicyServiceImpl) update(ctx context.Context, policy *model.Policy) error {
+
+	// The policy entity in SAC holds attributes which are not managed by this operator (e.g. the applications bound
+	// to it), therefore the existing policy is fetched first and the updated policy data is merged into it.
+	foundPolicyDTO, err := p.sacClient.FindPolicyByID(ctx, policy.ID)
+	if err != nil {
+		if errors.Is(err, sac.ErrorNotFound) {
+			return fmt.Errorf("%w policy id %s not found", typederror.UnrecoverableError, policy.ID)
+		}
+		return sacError("get policy "+policy.ID, err)
+	}
+
+	_, err = p.sacClient.UpdatePolicy(ctx, dto.MergePolicy(foundPolicyDTO, dto.FromPolicyModel(policy)))
+	if err != nil {
+		if errors.Is(err, sac.ErrorNotFound) {
+			return fmt.Errorf("%w policy id %s not found", typederror.UnrecoverableError, policy.ID)
+		}
+		return sacError("update policy "+policy.ID, err)
+	}
+	normalEvent(p.events, EventReasonPolicyUpdated, "updated policy %s in Secure-Access-Cloud", policy.ID)
+
+	return nil
+}
+
+func (p *PolicyServiceImpl) delete(ctx context.Context, id string) error {
+	p.log.Info("Deleting Policy: '" + id + "'...")
+
+	err := p.sacClient.DeletePolicy(ctx, id)
+	if err != nil && !errors.Is(err, sac.ErrorNotFound) {
+		return sacError("delete policy "+id, err)
+	}
+
+	p.log.Info("Policy: '" + id + "' deleted successfully.")
+	normalEvent(p.events, EventReasonPolicyDeleted, "deleted policy %s from Secure-Access-Cloud", id)
+	return nil
+}
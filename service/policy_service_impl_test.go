@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"bitbucket.org/accezz-io/sac-operator/model"
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
+	"bitbucket.org/accezz-io/sac-operator/service/sac/dto"
+	"bitbucket.org/accezz-io/sac-operator/utils/typederror"
+)
+
+func TestPolicyServiceImpl_Reconcile(t *testing.T) {
+	errorFromSacService := typederror.UnknownError
+	tests := []struct {
+		name      string
+		setupFunc func() (PolicyService, *model.Policy)
+		output    *PolicyReconcileOutput
+		err       error
+	}{
+		{
+			name: "nil policy flow",
+			setupFunc: func() (PolicyService, *model.Policy) {
+				sacClient := &sac.MockSecureAccessCloudClient{}
+				testLog := ctrl.Log.WithName("test")
+				return NewPolicyServiceImpl(sacClient, testLog), nil
+			},
+			output: &PolicyReconcileOutput{},
+			err:    typederror.UnrecoverableError,
+		},
+		{
+			name: "[delete policy flow] no policy ID",
+			setupFunc: func() (PolicyService, *model.Policy) {
+				sacClient := &sac.MockSecureAccessCloudClient{}
+				testLog := ctrl.Log.WithName("test")
+				return NewPolicyServiceImpl(sacClient, testLog), &model.Policy{ToDelete: true}
+			},
+			output: &PolicyReconcileOutput{},
+			err:    typederror.UnrecoverableError,
+		},
+		{
+			name: "[delete policy flow] failed to delete",
+			setupFunc: func() (PolicyService, *model.Policy) {
+				sacClient := &sac.MockSecureAccessCloudClient{}
+				sacClient.On("DeletePolicy", mock.Anything, "uuid").Return(errorFromSacService)
+				testLog := ctrl.Log.WithName("test")
+				return NewPolicyServiceImpl(sacClient, testLog), &model.Policy{ToDelete: true, ID: "uuid"}
+			},
+			output: &PolicyReconcileOutput{},
+			err:    errorFromSacService,
+		},
+		{
+			name: "[delete policy flow] already deleted",
+			setupFunc: func() (PolicyService, *model.Policy) {
+				sacClient := &sac.MockSecureAccessCloudClient{}
+				sacClient.On("DeletePolicy", mock.Anything, "uuid").Return(sac.ErrorNotFound)
+				testLog := ctrl.Log.WithName("test")
+				return NewPolicyServiceImpl(sacClient, testLog), &model.Policy{ToDelete: true, ID: "uuid"}
+			},
+			output: &PolicyReconcileOutput{Deleted: true},
+			err:    nil,
+		},
+		{
+			name: "[create policy flow] name already used",
+			setupFunc: func() (PolicyService, *model.Policy) {
+				sacClient := &sac.MockSecureAccessCloudClient{}
+				sacClient.On("FindPolicyByName", mock.Anything, model.AccessPolicy, "only-devops").Return(dto.PolicyDTO{ID: "uuid"}, nil)
+				testLog := ctrl.Log.WithName("test")
+				return NewPolicyServiceImpl(sacClient, testLog), &model.Policy{Name: "only-devops", Type: model.AccessPolicy}
+			},
+			output: &PolicyReconcileOutput{},
+			err:    typederror.UnrecoverableError,
+		},
+		{
+			name: "[create policy flow] success flow",
+			setupFunc: func() (PolicyService, *model.Policy) {
+				sacClient := &sac.MockSecureAccessCloudClient{}
+				sacClient.On("FindPolicyByName", mock.Anything, model.AccessPolicy, "only-devops").Return(dto.PolicyDTO{}, sac.ErrorNotFound)
+				sacClient.On("CreatePolicy", mock.Anything, mock.AnythingOfType("*dto.PolicyDTO")).Return(&dto.PolicyDTO{ID: "uuid"}, nil)
+				testLog := ctrl.Log.WithName("test")
+				return NewPolicyServiceImpl(sacClient, testLog), &model.Policy{Name: "only-devops", Type: model.AccessPolicy}
+			},
+			output: &PolicyReconcileOutput{SACPolicyID: "uuid"},
+			err:    nil,
+		},
+		{
+			name: "[update policy flow] policy not found",
+			setupFunc: func() (PolicyService, *model.Policy) {
+				sacClient := &sac.MockSecureAccessCloudClient{}
+				sacClient.On("FindPolicyByID", mock.Anything, "uuid").Return(&dto.PolicyDTO{}, sac.ErrorNotFound)
+				testLog := ctrl.Log.WithName("test")
+				return NewPolicyServiceImpl(sacClient, testLog), &model.Policy{ID: "uuid", Name: "only-devops"}
+			},
+			output: &PolicyReconcileOutput{SACPolicyID: "uuid"},
+			err:    typederror.UnrecoverableError,
+		},
+		{
+			name: "[update policy flow] success flow",
+			setupFunc: func() (PolicyService, *model.Policy) {
+				sacClient := &sac.MockSecureAccessCloudClient{}
+				sacClient.On("FindPolicyByID", mock.Anything, "uuid").Return(&dto.PolicyDTO{ID: "uuid", Name: "only-devops", Static: true}, nil)
+				sacClient.On("UpdatePolicy", mock.Anything, mock.MatchedBy(func(policy *dto.PolicyDTO) bool {
+					return policy.ID == "uuid" && policy.Static && !policy.Enabled
+				})).Return(&dto.PolicyDTO{ID: "uuid"}, nil)
+				testLog := ctrl.Log.WithName("test")
+				return NewPolicyServiceImpl(sacClient, testLog), &model.Policy{ID: "uuid", Name: "only-devops", Enabled: false}
+			},
+			output: &PolicyReconcileOutput{SACPolicyID: "uuid"},
+			err:    nil,
+		},
+		{
+			name: "[create policy flow] permission denied",
+			setupFunc: func() (PolicyService, *model.Policy) {
+				sacClient := &sac.MockSecureAccessCloudClient{}
+				sacClient.On("FindPolicyByName", mock.Anything, model.AccessPolicy, "only-devops").Return(dto.PolicyDTO{}, sac.ErrorNotFound)
+				sacClient.On("CreatePolicy", mock.Anything, mock.AnythingOfType("*dto.PolicyDTO")).Return(&dto.PolicyDTO{}, &sac.APIError{StatusCode: http.StatusForbidden})
+				testLog := ctrl.Log.WithName("test")
+				return NewPolicyServiceImpl(sacClient, testLog), &model.Policy{Name: "only-devops", Type: model.AccessPolicy}
+			},
+			output: &PolicyReconcileOutput{},
+			err:    typederror.UnrecoverableError,
+		},
+		{
+			name: "[create policy flow] name taken concurrently",
+			setupFunc: func() (PolicyService, *model.Policy) {
+				sacClient := &sac.MockSecureAccessCloudClient{}
+				sacClient.On("FindPolicyByName", mock.Anything, model.AccessPolicy, "only-devops").Return(dto.PolicyDTO{}, sac.ErrorNotFound)
+				sacClient.On("CreatePolicy", mock.Anything, mock.AnythingOfType("*dto.PolicyDTO")).Return(&dto.PolicyDTO{}, &sac.APIError{StatusCode: http.StatusConflict})
+				testLog := ctrl.Log.WithName("test")
+				return NewPolicyServiceImpl(sacClient, testLog), &model.Policy{Name: "only-devops", Type: model.AccessPolicy}
+			},
+			output: &PolicyReconcileOutput{},
+			err:    sac.ErrConflict,
+		},
+		{
+			name: "[update policy flow] rate limited",
+			setupFunc: func() (PolicyService, *model.Policy) {
+				sacClient := &sac.MockSecureAccessCloudClient{}
+				sacClient.On("FindPolicyByID", mock.Anything, "uuid").Return(&dto.PolicyDTO{}, &sac.APIError{StatusCode: http.StatusTooManyRequests})
+				testLog := ctrl.Log.WithName("test")
+				return NewPolicyServiceImpl(sacClient, testLog), &model.Policy{ID: "uuid", Name: "only-devops"}
+			},
+			output: &PolicyReconcileOutput{SACPolicyID: "uuid"},
+			err:    sac.ErrorRateLimited,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, input := test.setupFunc()
+			output, err := s.Reconcile(context.Background(), input)
+			assert.Equal(t, test.output, output)
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package sac
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"gopkg.in/resty.v1"
+)
+
+var ErrorPermissionDenied = fmt.Errorf("permission denied")
+var ErrorNotFound = fmt.Errorf("not found")
+var ErrConflict = fmt.Errorf("already exist")
+var ErrorUnauthorized = fmt.Errorf("unauthorized")
+var ErrorRateLimited = fmt.Errorf("rate limited")
+var ErrorServerError = fmt.Errorf("server error")
+
+// ErrorAmbiguousName is returned by the lookups by name when several objects have the looked up name.
+var ErrorAmbiguousName = fmt.Errorf("ambiguous name")
+
+// requestIDHeaders are the response headers which may hold the id Secure-Access-Cloud gave to a request.
+var requestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id", "X-Amzn-Requestid"}
+
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// APIError is the error of a request which Secure-Access-Cloud answered with an unexpected status. It matches, with
+// errors.Is, the error of its status: ErrorNotFound, ErrConflict, ErrorPermissionDenied, ErrorUnauthorized,
+// ErrorRateLimited or ErrorServerError.
+type APIError struct {
+	Method     string
+	Endpoint   string
+	StatusCode int
+	// The error code returned by Secure-Access-Cloud in the response body, if any.
+	Code string
+	// The error message returned by Secure-Access-Cloud in the response body, if any.
+	Message string
+	// The id of the request in Secure-Access-Cloud, to be given to its support.
+	RequestID string
+	Body      string
+}
+
+// apiErrorBody is the body of the error responses of Secure-Access-Cloud.
+type apiErrorBody struct {
+	Code      string `json:"code"`
+	ErrorCode string `json:"errorCode"`
+	Message   string `json:"message"`
+}
+
+func newAPIError(method string, endpoint string, response *resty.Response) *APIError {
+	apiError := &APIError{
+		Method:     method,
+		Endpoint:   endpoint,
+		StatusCode: response.StatusCode(),
+		Body:       response.String(),
+	}
+
+	var body apiErrorBody
+	if err := json.Unmarshal(response.Body(), &body); err == nil {
+		apiError.Code = body.Code
+		if apiError.Code == "" {
+			apiError.Code = body.ErrorCode
+		}
+		apiError.Message = body.Message
+	}
+
+	for _, header := range requestIDHeaders {
+		if id := response.Header().Get(header); id != "" {
+			apiError.RequestID = id
+			break
+		}
+	}
+
+	return apiError
+}
+
+func (e *APIError) Error() string {
+	message := fmt.Sprintf("%s %s failed with status-code: %d", e.Method, e.Endpoint, e.StatusCode)
+	if e.Code != "" {
+		message += ", code: " + e.Code
+	}
+	if e.Message != "" {
+		message += ", message: " + e.Message
+	} else if e.Body != "" {
+		message += " and body: " + e.Body
+	}
+	if e.RequestID != "" {
+		message += ", request-id: " + e.RequestID
+	}
+	return message
+}
+
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrorNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrorPermissionDenied:
+		return e.StatusCode == http.StatusForbidden
+	case ErrorUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrorRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrorServerError:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// IsClientError returns whether the request was rejected as invalid, e.g. a bad request or an unprocessable entity,
+// in which case sending it again cannot succeed.
+func (e *APIError) IsClientError() bool {
+	return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+}
@@ -0,0 +1,72 @@
+package sac
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/resty.v1"
+)
+
+func TestNewAPIError(t *testing.T) {
+	// given
+	response, err := resty.New().SetTransport(roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusForbidden,
+			Header:     http.Header{"X-Request-Id": {"request-1"}},
+			Body:       ioutil.NopCloser(strings.NewReader(`{"code":"AC-403","message":"missing role"}`)),
+		}, nil
+	})).R().Put("https://api.tenant.example.com/v2/sites/uuid")
+	require.NoError(t, err)
+
+	// when
+	apiError := newAPIError(http.MethodPut, "https://api.tenant.example.com/v2/sites/uuid", response)
+
+	// then
+	assert.Equal(t, "AC-403", apiError.Code)
+	assert.Equal(t, "missing role", apiError.Message)
+	assert.Equal(t, "request-1", apiError.RequestID)
+	assert.Equal(t, "PUT https://api.tenant.example.com/v2/sites/uuid failed with status-code: 403, code: AC-403, message: missing role, request-id: request-1", apiError.Error())
+}
+
+func TestAPIError_Is(t *testing.T) {
+	sentinels := []error{ErrorNotFound, ErrConflict, ErrorPermissionDenied, ErrorUnauthorized, ErrorRateLimited, ErrorServerError}
+	tests := []struct {
+		name       string
+		statusCode int
+		expected   error
+	}{
+		{name: "not found", statusCode: http.StatusNotFound, expected: ErrorNotFound},
+		{name: "conflict", statusCode: http.StatusConflict, expected: ErrConflict},
+		{name: "permission denied", statusCode: http.StatusForbidden, expected: ErrorPermissionDenied},
+		{name: "unauthorized", statusCode: http.StatusUnauthorized, expected: ErrorUnauthorized},
+		{name: "rate limited", statusCode: http.StatusTooManyRequests, expected: ErrorRateLimited},
+		{name: "server error", statusCode: http.StatusBadGateway, expected: ErrorServerError},
+		{name: "bad request", statusCode: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			err := fmt.Errorf("wrapped: %w", &APIError{StatusCode: tt.statusCode})
+
+			// then
+			for _, sentinel := range sentinels {
+				assert.Equal(t, sentinel == tt.expected, errors.Is(err, sentinel), sentinel.Error())
+			}
+			var apiError *APIError
+			assert.True(t, errors.As(err, &apiError))
+			assert.Equal(t, tt.statusCode, apiError.StatusCode)
+		})
+	}
+}
+
+func TestIsConflict(t *testing.T) {
+	assert.True(t, IsConflict(ErrConflict))
+	assert.True(t, IsConflict(fmt.Errorf("create site: %w", &APIError{StatusCode: http.StatusConflict})))
+	assert.False(t, IsConflict(&APIError{StatusCode: http.StatusNotFound}))
+}
@@ -0,0 +1,83 @@
+package sac
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	TenantDomainFileName = "tenantDomain"
+	ClientIDFileName     = "clientId"
+	ClientSecretFileName = "clientSecret"
+)
+
+// ReadSettingsFromDir reads the tenant settings from a directory holding the tenantDomain, clientId and clientSecret
+// files, e.g. a mounted credentials secret.
+func ReadSettingsFromDir(dir string) (*SecureAccessCloudSettings, error) {
+	values := map[string]string{}
+	for _, name := range []string{TenantDomainFileName, ClientIDFileName, ClientSecretFileName} {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the %s credentials file: %w", name, err)
+		}
+		value := strings.TrimSpace(string(content))
+		if value == "" {
+			return nil, fmt.Errorf("the %s credentials file is empty", name)
+		}
+		values[name] = value
+	}
+
+	return &SecureAccessCloudSettings{
+		TenantDomain: values[TenantDomainFileName],
+		ClientID:     values[ClientIDFileName],
+		ClientSecret: values[ClientSecretFileName],
+	}, nil
+}
+
+// CredentialsFileWatcher polls a credentials directory and registers the tenant client again whenever the credentials
+// change, so rotated credentials are used without restarting the operator. Polling is used rather than file
+// notifications since mounted secrets are updated by swapping symlinks.
+type CredentialsFileWatcher struct {
+	Dir      string
+	Tenant   string
+	Registry *SecureAccessCloudClientRegistry
+	Interval time.Duration
+	Log      logr.Logger
+}
+
+// Start polls the credentials directory until the context is done. It implements the controller-runtime Runnable
+// interface.
+func (w *CredentialsFileWatcher) Start(ctx context.Context) error {
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			w.reload()
+		}
+	}
+}
+
+func (w *CredentialsFileWatcher) reload() {
+	settings, err := ReadSettingsFromDir(w.Dir)
+	if err != nil {
+		// the files may be in the middle of an update, keep the current credentials until the next poll
+		w.Log.Error(err, "failed to read credentials", "dir", w.Dir)
+		return
+	}
+
+	generation := w.Registry.CredentialsGeneration(w.Tenant)
+	w.Registry.Register(w.Tenant, *settings)
+	if current := w.Registry.CredentialsGeneration(w.Tenant); current != generation {
+		w.Log.Info("credentials changed, using the new credentials", "generation", current)
+	}
+}
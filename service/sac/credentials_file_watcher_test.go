@@ -0,0 +1,63 @@
+package sac
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeCredentialsFiles(t *testing.T, dir string, tenantDomain, clientID, clientSecret string) {
+	for name, value := range map[string]string{
+		TenantDomainFileName: tenantDomain,
+		ClientIDFileName:     clientID,
+		ClientSecretFileName: clientSecret,
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(value), 0600))
+	}
+}
+
+func TestReadSettingsFromDir(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	writeCredentialsFiles(t, dir, "prod.example.com\n", "id", "secret")
+
+	// when
+	settings, err := ReadSettingsFromDir(dir)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, &SecureAccessCloudSettings{TenantDomain: "prod.example.com", ClientID: "id", ClientSecret: "secret"}, settings)
+
+	// when a file is missing, then an error is returned
+	require.NoError(t, os.Remove(filepath.Join(dir, ClientSecretFileName)))
+	_, err = ReadSettingsFromDir(dir)
+	assert.Error(t, err)
+}
+
+func TestCredentialsFileWatcher_reload(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	writeCredentialsFiles(t, dir, "prod.example.com", "id", "secret")
+	registry := NewSecureAccessCloudClientRegistry()
+	watcher := &CredentialsFileWatcher{Dir: dir, Tenant: DefaultTenant, Registry: registry, Log: logr.Discard()}
+	watcher.reload()
+	client, err := registry.Get(DefaultTenant)
+	require.NoError(t, err)
+
+	// when the credentials did not change, then the client is kept
+	watcher.reload()
+	assert.Equal(t, int64(1), registry.CredentialsGeneration(DefaultTenant))
+
+	// when the credentials were rotated, then a new client is registered
+	writeCredentialsFiles(t, dir, "prod.example.com", "id", "rotated-secret")
+	watcher.reload()
+	rotatedClient, err := registry.Get(DefaultTenant)
+	require.NoError(t, err)
+	assert.NotSame(t, client, rotatedClient)
+	assert.Equal(t, int64(2), registry.CredentialsGeneration(DefaultTenant))
+	assert.Equal(t, "rotated-secret", rotatedClient.(*SecureAccessCloudClientImpl).Setting.ClientSecret)
+}
@@ -18,6 +18,8 @@ type ApplicationDTO struct {
 	ConnectionSettings               ConnectionSettingsDTO             `json:"connectionSettings"`
 	HttpLinkTranslationSettings      *HttpLinkTranslationSettingsDTO   `json:"linkTranslationSettings,omitempty"`
 	HttpRequestCustomizationSettings *HttpRequestCustomizationSettings `json:"requestCustomizationSettings,omitempty"`
+	SshSettings                      *SshSettingsDTO                   `json:"sshSettings,omitempty"`
+	TcpTunnelSettings                []TcpTunnelSettingDTO             `json:"tcpTunnelSettings,omitempty"`
 }
 
 type ConnectionSettingsDTO struct {
@@ -44,6 +46,22 @@ type HttpRequestCustomizationSettings struct {
 	HeaderCustomization map[string]string `json:"headerCustomization"`
 }
 
+type SshSettingsDTO struct {
+	UserAccounts        []UserAccountDTO `json:"userAccounts"`
+	FullUPNAutoGenerate bool             `json:"fullUPNAutoGenerate"`
+	UPNAutoGenerate     bool             `json:"upnAutoGenerate"`
+	EmailAutoGenerate   bool             `json:"emailAutoGenerate"`
+}
+
+type UserAccountDTO struct {
+	Name string `json:"name"`
+}
+
+type TcpTunnelSettingDTO struct {
+	Target string   `json:"target"`
+	Ports  []string `json:"ports"`
+}
+
 type ApplicationPageDTO struct {
 	First            bool             `json:"first"`
 	Last             bool             `json:"last"`
@@ -87,10 +105,33 @@ func FromApplicationModel(application *model.Application) (*ApplicationDTO, erro
 			return nil, err
 		}
 	}
+	if application.SshSettings != nil {
+		dto.SshSettings = fromSshSettingsModel(application.SshSettings)
+	}
+	for _, tcpTunnelSetting := range application.TcpTunnelSettings {
+		dto.TcpTunnelSettings = append(dto.TcpTunnelSettings, TcpTunnelSettingDTO{
+			Target: tcpTunnelSetting.Target,
+			Ports:  tcpTunnelSetting.Ports,
+		})
+	}
 
 	return dto, nil
 }
 
+func fromSshSettingsModel(sshSettings *model.SshSettings) *SshSettingsDTO {
+	dto := &SshSettingsDTO{
+		UserAccounts:        []UserAccountDTO{},
+		FullUPNAutoGenerate: sshSettings.FullUPNAutoGenerate,
+		UPNAutoGenerate:     sshSettings.UPNAutoGenerate,
+		EmailAutoGenerate:   sshSettings.EmailAutoGenerate,
+	}
+	for _, userAccount := range sshSettings.UserAccounts {
+		dto.UserAccounts = append(dto.UserAccounts, UserAccountDTO{Name: userAccount})
+	}
+
+	return dto
+}
+
 type MergeOptions struct {
 	LinkTranslationSettings      bool
 	RequestCustomizationSettings bool
@@ -98,6 +139,9 @@ type MergeOptions struct {
 	ConnectionSettings           bool
 }
 
+// MergeApplication returns the existing application with the fields owned by the operator set to their desired values.
+// The optional settings which are not set in the desired application, e.g. a subdomain generated by
+// Secure-Access-Cloud, are not owned and keep their existing values.
 func MergeApplication(existingApplication *ApplicationDTO, updatedApplication *ApplicationDTO, options MergeOptions) *ApplicationDTO {
 	mergedApplication := *existingApplication
 
@@ -105,7 +149,48 @@ func MergeApplication(existingApplication *ApplicationDTO, updatedApplication *A
 	mergedApplication.Type = updatedApplication.Type
 	mergedApplication.SubType = updatedApplication.SubType
 	mergedApplication.IconUrl = updatedApplication.IconUrl
-	mergedApplication.ConnectionSettings.InternalAddress = updatedApplication.ConnectionSettings.InternalAddress
+	mergedApplication.IsVisible = updatedApplication.IsVisible
+	mergedApplication.IsNotificationEnabled = updatedApplication.IsNotificationEnabled
+	mergedApplication.Enabled = updatedApplication.Enabled
+
+	connectionSettings, updatedConnectionSettings := &mergedApplication.ConnectionSettings, updatedApplication.ConnectionSettings
+	connectionSettings.InternalAddress = updatedConnectionSettings.InternalAddress
+	mergeString(&connectionSettings.Subdomain, updatedConnectionSettings.Subdomain)
+	mergeString(&connectionSettings.CustomExternalAddress, updatedConnectionSettings.CustomExternalAddress)
+	mergeString(&connectionSettings.CustomRootPath, updatedConnectionSettings.CustomRootPath)
+	mergeString(&connectionSettings.HealthUrl, updatedConnectionSettings.HealthUrl)
+	mergeString(&connectionSettings.HealthMethod, updatedConnectionSettings.HealthMethod)
+	mergeString(&connectionSettings.CustomSSLCertificate, updatedConnectionSettings.CustomSSLCertificate)
+	mergeString(&connectionSettings.WildcardPrivateKey, updatedConnectionSettings.WildcardPrivateKey)
+
+	if updatedApplication.HttpLinkTranslationSettings != nil {
+		mergedApplication.HttpLinkTranslationSettings = updatedApplication.HttpLinkTranslationSettings
+	}
+	if updatedApplication.HttpRequestCustomizationSettings != nil {
+		mergedApplication.HttpRequestCustomizationSettings = updatedApplication.HttpRequestCustomizationSettings
+	}
+	if updatedApplication.SshSettings != nil {
+		mergedApplication.SshSettings = updatedApplication.SshSettings
+	}
+	// the tunnels of a tunnel based application are the desired ones, removing a target or all of them included
+	switch updatedApplication.Type {
+	case model.RDP, model.TCP, model.DynamicSSH:
+		mergedApplication.TcpTunnelSettings = updatedApplication.TcpTunnelSettings
+	}
 
 	return &mergedApplication
 }
+
+// DiffApplication returns the JSON paths of the fields of the existing application which differ from the merged
+// application. The wildcard private key is write only, it is not returned by Secure-Access-Cloud and is not compared.
+func DiffApplication(existingApplication *ApplicationDTO, mergedApplication *ApplicationDTO) ([]string, error) {
+	desiredApplication := *mergedApplication
+	desiredApplication.ConnectionSettings.WildcardPrivateKey = existingApplication.ConnectionSettings.WildcardPrivateKey
+	return Diff(existingApplication, &desiredApplication)
+}
+
+func mergeString(existing *string, updated string) {
+	if updated != "" {
+		*existing = updated
+	}
+}
@@ -42,13 +42,128 @@ func TestConvertFromApplicationModel(t *testing.T) {
 func TestMergeApplication(t *testing.T) {
 	// given
 	existingApplicationDTO := NewApplicationDTOBuilder().WithIsVisible(false).Build()
-	updatedApplicationDTO := NewApplicationDTOBuilder().WithName("new-name").Build()
+	existingApplicationDTO.ConnectionSettings.Subdomain = "generated-subdomain"
+	updatedApplicationDTO := NewApplicationDTOBuilder().WithName("new-name").WithIsVisible(true).Build()
+	updatedApplicationDTO.ConnectionSettings.Subdomain = ""
 
 	// when
 	result := MergeApplication(existingApplicationDTO, updatedApplicationDTO, MergeOptions{})
 
-	// then
+	// then the owned fields are set, and the subdomain generated by Secure-Access-Cloud is kept
 	assert.Equal(t, existingApplicationDTO.ID, result.ID)
 	assert.Equal(t, updatedApplicationDTO.Name, result.Name)
-	assert.Equal(t, false, result.IsVisible)
+	assert.Equal(t, true, result.IsVisible)
+	assert.Equal(t, "generated-subdomain", result.ConnectionSettings.Subdomain)
+}
+
+func TestDiffApplication_WildcardPrivateKey(t *testing.T) {
+	// given the private key, which is not returned by Secure-Access-Cloud
+	existingApplicationDTO := NewApplicationDTOBuilder().Build()
+	existingApplicationDTO.ConnectionSettings.WildcardPrivateKey = ""
+	updatedApplicationDTO := NewApplicationDTOBuilder().Build()
+	updatedApplicationDTO.ConnectionSettings.WildcardPrivateKey = "private-key"
+	mergedApplicationDTO := MergeApplication(existingApplicationDTO, updatedApplicationDTO, MergeOptions{})
+
+	// when
+	drift, err := DiffApplication(existingApplicationDTO, mergedApplicationDTO)
+
+	// then
+	assert.NoError(t, err)
+	assert.Empty(t, drift)
+	assert.Equal(t, "private-key", mergedApplicationDTO.ConnectionSettings.WildcardPrivateKey)
+}
+
+func TestMergeApplication_TcpTunnelSettings(t *testing.T) {
+	existingTunnels := []TcpTunnelSettingDTO{{Target: "db-0.db.default.svc.cluster.local", Ports: []string{"5432"}}}
+	tests := []struct {
+		name            string
+		applicationType model.ApplicationType
+		updatedTunnels  []TcpTunnelSettingDTO
+		expectedTunnels []TcpTunnelSettingDTO
+	}{
+		{
+			name:            "tcp tunnels replaced",
+			applicationType: model.TCP,
+			updatedTunnels:  []TcpTunnelSettingDTO{{Target: "db-1.db.default.svc.cluster.local", Ports: []string{"5432"}}},
+			expectedTunnels: []TcpTunnelSettingDTO{{Target: "db-1.db.default.svc.cluster.local", Ports: []string{"5432"}}},
+		},
+		{
+			name:            "tcp tunnels shrunk to empty",
+			applicationType: model.TCP,
+			expectedTunnels: nil,
+		},
+		{
+			name:            "rdp tunnels shrunk to empty",
+			applicationType: model.RDP,
+			expectedTunnels: nil,
+		},
+		{
+			name:            "dynamic ssh targets shrunk to empty",
+			applicationType: model.DynamicSSH,
+			expectedTunnels: nil,
+		},
+		{
+			name:            "not a tunnel based application",
+			applicationType: model.HTTP,
+			expectedTunnels: existingTunnels,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			existingApplicationDTO := NewApplicationDTOBuilder().Build()
+			existingApplicationDTO.Type = tt.applicationType
+			existingApplicationDTO.TcpTunnelSettings = existingTunnels
+			updatedApplicationDTO := NewApplicationDTOBuilder().Build()
+			updatedApplicationDTO.Type = tt.applicationType
+			updatedApplicationDTO.TcpTunnelSettings = tt.updatedTunnels
+
+			// when
+			result := MergeApplication(existingApplicationDTO, updatedApplicationDTO, MergeOptions{})
+
+			// then
+			assert.Equal(t, tt.expectedTunnels, result.TcpTunnelSettings)
+		})
+	}
+}
+
+func TestConvertFromApplicationModelWithSshSettings(t *testing.T) {
+	// given
+	applicationModel := model.NewApplicationBuilder().Build()
+	applicationModel.Type = model.SSH
+	applicationModel.SshSettings = &model.SshSettings{
+		UserAccounts:    []string{"ubuntu", "root"},
+		UPNAutoGenerate: true,
+	}
+
+	// when
+	result, err := FromApplicationModel(applicationModel)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, &SshSettingsDTO{
+		UserAccounts:    []UserAccountDTO{{Name: "ubuntu"}, {Name: "root"}},
+		UPNAutoGenerate: true,
+	}, result.SshSettings)
+}
+
+func TestConvertFromApplicationModelWithTcpTunnelSettings(t *testing.T) {
+	// given
+	applicationModel := model.NewApplicationBuilder().Build()
+	applicationModel.Type = model.RDP
+	applicationModel.SubType = model.RdpMultipleMachines
+	applicationModel.TcpTunnelSettings = []model.TcpTunnelSetting{
+		{Target: "windows-vm-1.vms", Ports: []string{"3389"}},
+		{Target: "windows-vm-2.vms", Ports: []string{"3390"}},
+	}
+
+	// when
+	result, err := FromApplicationModel(applicationModel)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, []TcpTunnelSettingDTO{
+		{Target: "windows-vm-1.vms", Ports: []string{"3389"}},
+		{Target: "windows-vm-2.vms", Ports: []string{"3390"}},
+	}, result.TcpTunnelSettings)
 }
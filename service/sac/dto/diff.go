@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// Diff returns the JSON paths of the fields whose values differ between the existing and the desired objects, e.g.
+// "connectionSettings.internalAddress". Objects are compared field by field, any other value (including a list) is
+// compared as a whole.
+func Diff(existing interface{}, desired interface{}) ([]string, error) {
+	existingValue, err := toJSONValue(existing)
+	if err != nil {
+		return nil, err
+	}
+	desiredValue, err := toJSONValue(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	diffValues("", existingValue, desiredValue, &paths)
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func diffValues(path string, existing interface{}, desired interface{}, paths *[]string) {
+	existingObject, existingIsObject := existing.(map[string]interface{})
+	desiredObject, desiredIsObject := desired.(map[string]interface{})
+	if !existingIsObject || !desiredIsObject {
+		if !reflect.DeepEqual(existing, desired) {
+			*paths = append(*paths, path)
+		}
+		return
+	}
+
+	keys := map[string]struct{}{}
+	for key := range existingObject {
+		keys[key] = struct{}{}
+	}
+	for key := range desiredObject {
+		keys[key] = struct{}{}
+	}
+	for key := range keys {
+		fieldPath := key
+		if path != "" {
+			fieldPath = path + "." + key
+		}
+		diffValues(fieldPath, existingObject[key], desiredObject[key], paths)
+	}
+}
+
+func toJSONValue(obj interface{}) (interface{}, error) {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	err = json.Unmarshal(raw, &value)
+	return value, err
+}
@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	existing := &ApplicationDTO{
+		ID:                 "uuid",
+		Name:               "my-app",
+		ConnectionSettings: ConnectionSettingsDTO{InternalAddress: "http://changed-in-portal:80", Subdomain: "my-app"},
+		TcpTunnelSettings:  []TcpTunnelSettingDTO{{Target: "db.default", Ports: []string{"5432"}}},
+	}
+	desired := &ApplicationDTO{
+		ID:                 "uuid",
+		Name:               "my-app",
+		Enabled:            true,
+		ConnectionSettings: ConnectionSettingsDTO{InternalAddress: "http://my-service.default:80", Subdomain: "my-app"},
+		TcpTunnelSettings:  []TcpTunnelSettingDTO{{Target: "db.default", Ports: []string{"5432", "5433"}}},
+	}
+
+	// when
+	diff, err := Diff(existing, desired)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"connectionSettings.internalAddress", "enabled", "tcpTunnelSettings"}, diff)
+
+	// when the objects are equal, then there is no diff
+	diff, err = Diff(existing, existing)
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+}
@@ -2,6 +2,8 @@ package dto
 
 import (
 	"time"
+
+	"bitbucket.org/accezz-io/sac-operator/model"
 )
 
 type PoliciesPageDTO struct {
@@ -26,14 +28,105 @@ type PolicyDTO struct {
 	CreatedAt         time.Time            `json:"createdAt"`
 	DirectoryEntities []DirectoryEntityDTO `json:"directoryEntities"`
 	Enabled           bool                 `json:"enabled"`
-	FilterConditions  []interface{}        `json:"filterConditions"`
-	ID                string               `json:"id"`
+	FilterConditions  []FilterConditionDTO `json:"filterConditions"`
+	ID                string               `json:"id,omitempty"`
 	IsDefault         bool                 `json:"isDefault"`
 	ModifiedOn        time.Time            `json:"modifiedOn"`
 	Name              string               `json:"name"`
+	Rules             []RuleDTO            `json:"rules,omitempty"`
 	Static            bool                 `json:"static"`
 	TargetProtocol    string               `json:"targetProtocol"`
 	Type              string               `json:"type"`
 	Validators        struct {
 	} `json:"validators"`
 }
+
+type FilterConditionDTO struct {
+	Arguments map[string][]string `json:"arguments"`
+	Positive  bool                `json:"positive"`
+	Type      string              `json:"type"`
+}
+
+type RuleDTO struct {
+	Action     string             `json:"action"`
+	Conditions []RuleConditionDTO `json:"conditions"`
+	Targets    []RuleTargetDTO    `json:"targets"`
+}
+
+type RuleConditionDTO struct {
+	Arguments map[string][]string `json:"arguments"`
+	Type      string              `json:"type"`
+}
+
+type RuleTargetDTO struct {
+	Arguments map[string][]string `json:"arguments"`
+	Type      string              `json:"type"`
+}
+
+func FromPolicyModel(policy *model.Policy) *PolicyDTO {
+	dto := &PolicyDTO{
+		ID:                policy.ID,
+		Name:              policy.Name,
+		Type:              policy.Type.String(),
+		TargetProtocol:    policy.TargetProtocol.String(),
+		Enabled:           policy.Enabled,
+		DirectoryEntities: []DirectoryEntityDTO{},
+		FilterConditions:  []FilterConditionDTO{},
+	}
+
+	for _, directoryEntity := range policy.DirectoryEntities {
+		dto.DirectoryEntities = append(dto.DirectoryEntities, DirectoryEntityDTO{
+			DisplayName:          directoryEntity.DisplayName,
+			IdentifierInProvider: directoryEntity.IdentifierInProvider,
+			IdentityProviderID:   directoryEntity.IdentityProviderID,
+			IdentityProviderType: directoryEntity.IdentityProviderType,
+			Type:                 directoryEntity.Type,
+		})
+	}
+	for _, filterCondition := range policy.FilterConditions {
+		dto.FilterConditions = append(dto.FilterConditions, FilterConditionDTO{
+			Arguments: filterCondition.Arguments,
+			Positive:  filterCondition.Positive,
+			Type:      filterCondition.Type,
+		})
+	}
+
+	for _, rule := range policy.Rules {
+		dto.Rules = append(dto.Rules, fromPolicyRuleModel(rule))
+	}
+
+	return dto
+}
+
+func fromPolicyRuleModel(rule model.PolicyRule) RuleDTO {
+	dto := RuleDTO{
+		Action:     rule.Action,
+		Conditions: []RuleConditionDTO{},
+		Targets:    []RuleTargetDTO{},
+	}
+	for _, condition := range rule.Conditions {
+		dto.Conditions = append(dto.Conditions, RuleConditionDTO{Arguments: condition.Arguments, Type: condition.Type})
+	}
+	for _, target := range rule.Targets {
+		dto.Targets = append(dto.Targets, RuleTargetDTO{Arguments: target.Arguments, Type: target.Type})
+	}
+
+	return dto
+}
+
+// MergePolicy merges the policy settings managed by the operator into the existing policy in SAC, keeping the
+// attributes which are not managed here (e.g. the applications bound to the policy) untouched.
+func MergePolicy(existingPolicy *PolicyDTO, updatedPolicy *PolicyDTO) *PolicyDTO {
+	mergedPolicy := *existingPolicy
+
+	mergedPolicy.Name = updatedPolicy.Name
+	mergedPolicy.Enabled = updatedPolicy.Enabled
+	mergedPolicy.TargetProtocol = updatedPolicy.TargetProtocol
+	mergedPolicy.DirectoryEntities = updatedPolicy.DirectoryEntities
+	mergedPolicy.FilterConditions = updatedPolicy.FilterConditions
+	if updatedPolicy.Type == model.ActivityPolicy.String() {
+		mergedPolicy.Rules = updatedPolicy.Rules
+	}
+
+	return &mergedPolicy
+}
@@ -0,0 +1,107 @@
+package dto
+
+import (
+	"testing"
+
+	"bitbucket.org/accezz-io/sac-operator/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertFromPolicyModel(t *testing.T) {
+	// given
+	policyModel := &model.Policy{
+		ID:             "uuid",
+		Name:           "only-devops",
+		Type:           model.AccessPolicy,
+		TargetProtocol: model.HTTP,
+		Enabled:        true,
+		DirectoryEntities: []model.DirectoryEntity{
+			{IdentifierInProvider: "devops", IdentityProviderID: "idp", IdentityProviderType: "local", Type: "Group"},
+		},
+		FilterConditions: []model.FilterCondition{
+			{Type: "IP_UTILS_COUNTRY", Positive: true, Arguments: map[string][]string{"countries": {"US"}}},
+		},
+	}
+
+	// when
+	result := FromPolicyModel(policyModel)
+
+	// then
+	assert.Equal(t, "uuid", result.ID)
+	assert.Equal(t, "only-devops", result.Name)
+	assert.Equal(t, "ACCESS", result.Type)
+	assert.Equal(t, "HTTP", result.TargetProtocol)
+	assert.True(t, result.Enabled)
+	assert.Equal(t, []DirectoryEntityDTO{
+		{IdentifierInProvider: "devops", IdentityProviderID: "idp", IdentityProviderType: "local", Type: "Group"},
+	}, result.DirectoryEntities)
+	assert.Equal(t, []FilterConditionDTO{
+		{Type: "IP_UTILS_COUNTRY", Positive: true, Arguments: map[string][]string{"countries": {"US"}}},
+	}, result.FilterConditions)
+}
+
+func TestMergePolicy(t *testing.T) {
+	// given
+	existingPolicy := &PolicyDTO{
+		ID:                "uuid",
+		Name:              "only-devops",
+		Enabled:           true,
+		IsDefault:         true,
+		DirectoryEntities: []DirectoryEntityDTO{{IdentifierInProvider: "devops"}},
+	}
+	updatedPolicy := &PolicyDTO{
+		Name:              "only-devops",
+		Enabled:           false,
+		DirectoryEntities: []DirectoryEntityDTO{},
+	}
+
+	// when
+	result := MergePolicy(existingPolicy, updatedPolicy)
+
+	// then
+	assert.Equal(t, "uuid", result.ID)
+	assert.True(t, result.IsDefault)
+	assert.False(t, result.Enabled)
+	assert.Empty(t, result.DirectoryEntities)
+}
+
+func TestConvertFromPolicyModelWithRules(t *testing.T) {
+	// given
+	policyModel := &model.Policy{
+		Name:           "block-deletes",
+		Type:           model.ActivityPolicy,
+		TargetProtocol: model.HTTP,
+		Rules: []model.PolicyRule{
+			{
+				Action:  "BLOCK",
+				Targets: []model.PolicyRuleTarget{{Type: "METHOD", Arguments: map[string][]string{"methods": {"DELETE"}}}},
+			},
+		},
+	}
+
+	// when
+	result := FromPolicyModel(policyModel)
+
+	// then
+	assert.Equal(t, "ACTIVITY", result.Type)
+	assert.Equal(t, []RuleDTO{
+		{
+			Action:     "BLOCK",
+			Conditions: []RuleConditionDTO{},
+			Targets:    []RuleTargetDTO{{Type: "METHOD", Arguments: map[string][]string{"methods": {"DELETE"}}}},
+		},
+	}, result.Rules)
+}
+
+func TestMergePolicyWithRules(t *testing.T) {
+	// given
+	existingPolicy := &PolicyDTO{ID: "uuid", Type: "ACTIVITY", Rules: []RuleDTO{{Action: "ALLOW"}}}
+	updatedPolicy := &PolicyDTO{Type: "ACTIVITY", Rules: []RuleDTO{{Action: "BLOCK"}}}
+
+	// when
+	result := MergePolicy(existingPolicy, updatedPolicy)
+
+	// then
+	assert.Equal(t, "uuid", result.ID)
+	assert.Equal(t, []RuleDTO{{Action: "BLOCK"}}, result.Rules)
+}
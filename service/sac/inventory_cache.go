@@ -0,0 +1,390 @@
+package sac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"bitbucket.org/accezz-io/sac-operator/model"
+	"bitbucket.org/accezz-io/sac-operator/service/sac/dto"
+	"golang.org/x/sync/singleflight"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	inventorySites        = "sites"
+	inventoryPolicies     = "policies"
+	inventoryApplications = "applications"
+)
+
+var inventoryKinds = []string{inventorySites, inventoryPolicies, inventoryApplications}
+
+// inventoryObject is a site, a policy or an application held by the inventory.
+type inventoryObject struct {
+	id    string
+	name  string
+	value interface{}
+}
+
+// inventoryIndex indexes the objects of a kind by their name.
+type inventoryIndex struct {
+	loadedAt time.Time
+	byName   map[string][]inventoryObject
+}
+
+// InventoryCache is a SecureAccessCloudClient resolving the sites, the policies and the applications by name from an
+// in-memory index of the tenant inventory, instead of requesting Secure-Access-Cloud for every lookup. The index of a
+// kind is loaded by listing all its objects, and listed again once it is older than the TTL. A name missing from the
+// index is looked up in Secure-Access-Cloud, so the objects created outside the cluster are found before the next
+// listing. The objects changed through the cache, or not found by id, are dropped from the index, and the concurrent
+// listings and lookups of the same name are made once.
+//
+// The index only holds the objects identity, their id, their name and their type, which the lookups return: their
+// content, such as the connectors and the applications of a site or of a policy, is changed by calls which do not
+// go through the index, so the objects are fetched by id when their content matters.
+type InventoryCache struct {
+	SecureAccessCloudClient
+	tenant string
+	ttl    time.Duration
+
+	mu      sync.RWMutex
+	indexes map[string]*inventoryIndex
+	// The number of changes made to every kind, which invalidate the listings made meanwhile.
+	generations map[string]int64
+	group       singleflight.Group
+}
+
+func NewInventoryCache(tenant string, client SecureAccessCloudClient, ttl time.Duration) *InventoryCache {
+	return &InventoryCache{
+		SecureAccessCloudClient: client,
+		tenant:                  tenant,
+		ttl:                     ttl,
+		indexes:                 map[string]*inventoryIndex{},
+		generations:             map[string]int64{},
+	}
+}
+
+// Warm loads the index of every kind.
+func (c *InventoryCache) Warm(ctx context.Context) error {
+	for _, kind := range inventoryKinds {
+		if err := c.load(ctx, kind); err != nil {
+			return fmt.Errorf("failed to list the %s: %w", kind, err)
+		}
+	}
+	return nil
+}
+
+// ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+// Lookups
+// ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (c *InventoryCache) FindSiteByName(ctx context.Context, name string) (*dto.SiteDTO, error) {
+	object, err := c.lookup(ctx, inventorySites, name, func(ctx context.Context) (inventoryObject, error) {
+		site, err := c.SecureAccessCloudClient.FindSiteByName(ctx, name)
+		if err != nil {
+			return inventoryObject{}, err
+		}
+		return siteIdentity(*site), nil
+	})
+	if err != nil {
+		return &dto.SiteDTO{}, err
+	}
+
+	site := object.value.(dto.SiteDTO)
+	return &site, nil
+}
+
+// FindPolicyByName looks up the policy by its type and name, the policies are indexed by both as an access policy and an
+// activity policy may have the same name.
+func (c *InventoryCache) FindPolicyByName(ctx context.Context, policyType model.PolicyType, name string) (dto.PolicyDTO, error) {
+	object, err := c.lookup(ctx, inventoryPolicies, policyIndexName(policyType.String(), name), func(ctx context.Context) (inventoryObject, error) {
+		policy, err := c.SecureAccessCloudClient.FindPolicyByName(ctx, policyType, name)
+		if err != nil {
+			return inventoryObject{}, err
+		}
+		return policyIdentity(policy), nil
+	})
+	if err != nil {
+		return dto.PolicyDTO{}, err
+	}
+
+	return object.value.(dto.PolicyDTO), nil
+}
+
+func (c *InventoryCache) FindPoliciesByNames(ctx context.Context, references []model.PolicyReference) ([]dto.PolicyDTO, error) {
+	var results []dto.PolicyDTO
+
+	for _, reference := range references {
+		policyDTO, err := c.FindPolicyByName(ctx, reference.Type, reference.Name)
+		if err != nil {
+			return results, err
+		}
+
+		results = append(results, policyDTO)
+	}
+
+	return results, nil
+}
+
+func (c *InventoryCache) FindApplicationByName(ctx context.Context, name string) (*dto.ApplicationDTO, error) {
+	object, err := c.lookup(ctx, inventoryApplications, name, func(ctx context.Context) (inventoryObject, error) {
+		application, err := c.SecureAccessCloudClient.FindApplicationByName(ctx, name)
+		if err != nil {
+			return inventoryObject{}, err
+		}
+		return applicationIdentity(*application), nil
+	})
+	if err != nil {
+		return &dto.ApplicationDTO{}, err
+	}
+
+	application := object.value.(dto.ApplicationDTO)
+	return &application, nil
+}
+
+// FindSiteByID drops a site which is not found from the index, as it was deleted outside the cluster and its name may
+// be used again.
+func (c *InventoryCache) FindSiteByID(ctx context.Context, id string) (*dto.SiteDTO, error) {
+	site, err := c.SecureAccessCloudClient.FindSiteByID(ctx, id)
+	if errors.Is(err, ErrorNotFound) {
+		c.forget(inventorySites, id, "")
+	}
+	return site, err
+}
+
+// FindApplicationByID drops an application which is not found from the index, as it was deleted outside the cluster
+// and its name may be used again.
+func (c *InventoryCache) FindApplicationByID(ctx context.Context, id string) (*dto.ApplicationDTO, error) {
+	application, err := c.SecureAccessCloudClient.FindApplicationByID(ctx, id)
+	if errors.Is(err, ErrorNotFound) {
+		c.forget(inventoryApplications, id, "")
+	}
+	return application, err
+}
+
+// FindPolicyByID drops a policy which is not found from the index, as it was deleted outside the cluster and its
+// name may be used again.
+func (c *InventoryCache) FindPolicyByID(ctx context.Context, id string) (*dto.PolicyDTO, error) {
+	policy, err := c.SecureAccessCloudClient.FindPolicyByID(ctx, id)
+	if errors.Is(err, ErrorNotFound) {
+		c.forget(inventoryPolicies, id, "")
+	}
+	return policy, err
+}
+
+// ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+// Mutations
+// ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (c *InventoryCache) CreateApplication(ctx context.Context, applicationDTO *dto.ApplicationDTO) (*dto.ApplicationDTO, error) {
+	defer c.forget(inventoryApplications, "", applicationDTO.Name)
+	return c.SecureAccessCloudClient.CreateApplication(ctx, applicationDTO)
+}
+
+func (c *InventoryCache) UpdateApplication(ctx context.Context, applicationDTO *dto.ApplicationDTO) (*dto.ApplicationDTO, error) {
+	defer c.forget(inventoryApplications, applicationDTO.ID, applicationDTO.Name)
+	return c.SecureAccessCloudClient.UpdateApplication(ctx, applicationDTO)
+}
+
+func (c *InventoryCache) DeleteApplication(ctx context.Context, id string) error {
+	defer c.forget(inventoryApplications, id, "")
+	return c.SecureAccessCloudClient.DeleteApplication(ctx, id)
+}
+
+func (c *InventoryCache) CreatePolicy(ctx context.Context, policyDTO *dto.PolicyDTO) (*dto.PolicyDTO, error) {
+	defer c.forget(inventoryPolicies, "", policyIndexName(policyDTO.Type, policyDTO.Name))
+	return c.SecureAccessCloudClient.CreatePolicy(ctx, policyDTO)
+}
+
+func (c *InventoryCache) UpdatePolicy(ctx context.Context, policyDTO *dto.PolicyDTO) (*dto.PolicyDTO, error) {
+	defer c.forget(inventoryPolicies, policyDTO.ID, policyIndexName(policyDTO.Type, policyDTO.Name))
+	return c.SecureAccessCloudClient.UpdatePolicy(ctx, policyDTO)
+}
+
+func (c *InventoryCache) DeletePolicy(ctx context.Context, id string) error {
+	defer c.forget(inventoryPolicies, id, "")
+	return c.SecureAccessCloudClient.DeletePolicy(ctx, id)
+}
+
+func (c *InventoryCache) CreateSite(ctx context.Context, siteDTO *dto.SiteDTO) (*dto.SiteDTO, error) {
+	defer c.forget(inventorySites, "", siteDTO.Name)
+	return c.SecureAccessCloudClient.CreateSite(ctx, siteDTO)
+}
+
+func (c *InventoryCache) DeleteSite(ctx context.Context, id string) error {
+	defer c.forget(inventorySites, id, "")
+	return c.SecureAccessCloudClient.DeleteSite(ctx, id)
+}
+
+// ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+// Private Functions
+// ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// lookup returns the only object of the given kind having the name. The object is looked up in Secure-Access-Cloud,
+// with find, when the index cannot be loaded or does not hold the name.
+func (c *InventoryCache) lookup(ctx context.Context, kind string, name string, find func(ctx context.Context) (inventoryObject, error)) (inventoryObject, error) {
+	if err := c.load(ctx, kind); err != nil {
+		ctrllog.FromContext(ctx).Info("failed to list the Secure-Access-Cloud inventory, looking up by name", "kind", kind, "error", err.Error())
+	} else if objects := c.indexed(kind, name); len(objects) > 0 {
+		inventoryLookups.WithLabelValues(tenantMetricLabel(c.tenant), kind, "hit").Inc()
+		i, err := exactNameMatch(kind, name, len(objects), func(i int) string { return objects[i].name })
+		if err != nil {
+			return inventoryObject{}, err
+		}
+		return objects[i], nil
+	}
+	inventoryLookups.WithLabelValues(tenantMetricLabel(c.tenant), kind, "miss").Inc()
+
+	result, err, _ := c.group.Do(kind+"/"+name, func() (interface{}, error) {
+		object, err := find(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.add(kind, object)
+		return object, nil
+	})
+	if err != nil {
+		return inventoryObject{}, err
+	}
+	return result.(inventoryObject), nil
+}
+
+// load loads the index of the given kind, listing the objects of the kind again when the index expired. A listing
+// which was concurrent to a change made through the cache may miss the change, it is not kept.
+func (c *InventoryCache) load(ctx context.Context, kind string) error {
+	c.mu.RLock()
+	index, ok := c.indexes[kind]
+	c.mu.RUnlock()
+	if ok && time.Since(index.loadedAt) < c.ttl {
+		return nil
+	}
+
+	_, err, _ := c.group.Do(kind, func() (interface{}, error) {
+		c.mu.RLock()
+		generation := c.generations[kind]
+		c.mu.RUnlock()
+
+		objects, err := c.list(ctx, kind)
+		if err != nil {
+			return nil, err
+		}
+
+		index := &inventoryIndex{loadedAt: time.Now(), byName: map[string][]inventoryObject{}}
+		for _, object := range objects {
+			index.byName[object.name] = append(index.byName[object.name], object)
+		}
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.generations[kind] == generation {
+			c.indexes[kind] = index
+			inventorySize.WithLabelValues(tenantMetricLabel(c.tenant), kind).Set(float64(len(objects)))
+		}
+		return nil, nil
+	})
+	return err
+}
+
+// indexed returns the objects of the given kind having the name in the index.
+func (c *InventoryCache) indexed(kind string, name string) []inventoryObject {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if index, ok := c.indexes[kind]; ok {
+		return index.byName[name]
+	}
+	return nil
+}
+
+func (c *InventoryCache) list(ctx context.Context, kind string) ([]inventoryObject, error) {
+	var objects []inventoryObject
+
+	switch kind {
+	case inventorySites:
+		sites, err := c.SecureAccessCloudClient.ListSites(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, site := range sites {
+			objects = append(objects, siteIdentity(site))
+		}
+	case inventoryPolicies:
+		policies, err := c.SecureAccessCloudClient.ListPolicies(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, policy := range policies {
+			objects = append(objects, policyIdentity(policy))
+		}
+	case inventoryApplications:
+		applications, err := c.SecureAccessCloudClient.ListApplications(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, application := range applications {
+			objects = append(objects, applicationIdentity(application))
+		}
+	}
+
+	return objects, nil
+}
+
+func siteIdentity(site dto.SiteDTO) inventoryObject {
+	return inventoryObject{id: site.ID, name: site.Name, value: dto.SiteDTO{ID: site.ID, Name: site.Name}}
+}
+
+func policyIdentity(policy dto.PolicyDTO) inventoryObject {
+	return inventoryObject{id: policy.ID, name: policyIndexName(policy.Type, policy.Name), value: dto.PolicyDTO{ID: policy.ID, Name: policy.Name, Type: policy.Type}}
+}
+
+// policyIndexName returns the name under which a policy is indexed, made of its type and its name.
+func policyIndexName(policyType string, name string) string {
+	return policyType + "/" + name
+}
+
+func applicationIdentity(application dto.ApplicationDTO) inventoryObject {
+	return inventoryObject{id: application.ID, name: application.Name, value: dto.ApplicationDTO{ID: application.ID, Name: application.Name, Type: application.Type}}
+}
+
+// add adds an object looked up by name to the index of its kind, if loaded.
+func (c *InventoryCache) add(kind string, object inventoryObject) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if index, ok := c.indexes[kind]; ok {
+		index.byName[object.name] = []inventoryObject{object}
+	}
+}
+
+// forget drops from the index of the given kind the object with the id, and the objects with the name, so that they
+// are looked up again in Secure-Access-Cloud.
+func (c *InventoryCache) forget(kind string, id string, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generations[kind]++
+	index, ok := c.indexes[kind]
+	if !ok {
+		return
+	}
+
+	if name != "" {
+		delete(index.byName, name)
+	}
+	if id == "" {
+		return
+	}
+	for objectName, objects := range index.byName {
+		for i := range objects {
+			if objects[i].id == id {
+				index.byName[objectName] = append(objects[:i:i], objects[i+1:]...)
+				break
+			}
+		}
+		if len(index.byName[objectName]) == 0 {
+			delete(index.byName, objectName)
+		}
+	}
+}
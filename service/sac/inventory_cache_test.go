@@ -0,0 +1,228 @@
+package sac
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"bitbucket.org/accezz-io/sac-operator/model"
+	"bitbucket.org/accezz-io/sac-operator/service/sac/dto"
+)
+
+func TestInventoryCache_Warm(t *testing.T) {
+	// given
+	sacClient := &MockSecureAccessCloudClient{}
+	sacClient.On("ListSites", mock.Anything).Return([]dto.SiteDTO{{ID: "site-uuid", Name: "prod"}, {ID: "site-eu-uuid", Name: "prod-eu"}}, nil).Once()
+	sacClient.On("ListPolicies", mock.Anything).Return([]dto.PolicyDTO{{ID: "policy-uuid", Name: "admins", Type: "ACCESS"}}, nil).Once()
+	sacClient.On("ListApplications", mock.Anything).Return([]dto.ApplicationDTO{{ID: "app-uuid", Name: "app"}}, nil).Once()
+	cache := NewInventoryCache("staging", sacClient, time.Minute)
+
+	// when
+	err := cache.Warm(context.Background())
+
+	// then the lookups are answered by the cache
+	require.NoError(t, err)
+	site, err := cache.FindSiteByName(context.Background(), "prod")
+	assert.NoError(t, err)
+	assert.Equal(t, "site-uuid", site.ID)
+	policies, err := cache.FindPoliciesByNames(context.Background(), []model.PolicyReference{{Type: model.AccessPolicy, Name: "admins"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.PolicyDTO{{ID: "policy-uuid", Name: "admins", Type: "ACCESS"}}, policies)
+	application, err := cache.FindApplicationByName(context.Background(), "app")
+	assert.NoError(t, err)
+	assert.Equal(t, "app-uuid", application.ID)
+	sacClient.AssertExpectations(t)
+	sacClient.AssertNotCalled(t, "FindSiteByName", mock.Anything, mock.Anything)
+}
+
+func TestInventoryCache_FindSiteByName(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupFunc     func(sacClient *MockSecureAccessCloudClient)
+		expectedID    string
+		expectedError error
+	}{
+		{
+			name: "listed site",
+			setupFunc: func(sacClient *MockSecureAccessCloudClient) {
+				sacClient.On("ListSites", mock.Anything).Return([]dto.SiteDTO{{ID: "site-uuid", Name: "prod"}}, nil)
+			},
+			expectedID: "site-uuid",
+		},
+		{
+			name: "site created after the listing",
+			setupFunc: func(sacClient *MockSecureAccessCloudClient) {
+				sacClient.On("ListSites", mock.Anything).Return([]dto.SiteDTO{{ID: "site-eu-uuid", Name: "prod-eu"}}, nil)
+				sacClient.On("FindSiteByName", mock.Anything, "prod").Return(&dto.SiteDTO{ID: "site-uuid", Name: "prod"}, nil)
+			},
+			expectedID: "site-uuid",
+		},
+		{
+			name: "missing site",
+			setupFunc: func(sacClient *MockSecureAccessCloudClient) {
+				sacClient.On("ListSites", mock.Anything).Return([]dto.SiteDTO{}, nil)
+				sacClient.On("FindSiteByName", mock.Anything, "prod").Return(&dto.SiteDTO{}, ErrorNotFound)
+			},
+			expectedError: ErrorNotFound,
+		},
+		{
+			name: "duplicate names",
+			setupFunc: func(sacClient *MockSecureAccessCloudClient) {
+				sacClient.On("ListSites", mock.Anything).Return([]dto.SiteDTO{{ID: "site-uuid", Name: "prod"}, {ID: "other-uuid", Name: "prod"}}, nil)
+			},
+			expectedError: ErrorAmbiguousName,
+		},
+		{
+			name: "listing failed",
+			setupFunc: func(sacClient *MockSecureAccessCloudClient) {
+				sacClient.On("ListSites", mock.Anything).Return(nil, errors.New("unavailable"))
+				sacClient.On("FindSiteByName", mock.Anything, "prod").Return(&dto.SiteDTO{ID: "site-uuid", Name: "prod"}, nil)
+			},
+			expectedID: "site-uuid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			sacClient := &MockSecureAccessCloudClient{}
+			tt.setupFunc(sacClient)
+			cache := NewInventoryCache("staging", sacClient, time.Minute)
+
+			// when
+			site, err := cache.FindSiteByName(context.Background(), "prod")
+
+			// then
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedID, site.ID)
+		})
+	}
+}
+
+func TestInventoryCache_Invalidation(t *testing.T) {
+	// given
+	sacClient := &MockSecureAccessCloudClient{}
+	sacClient.On("ListApplications", mock.Anything).Return([]dto.ApplicationDTO{{ID: "app-uuid", Name: "app"}}, nil).Once()
+	sacClient.On("DeleteApplication", mock.Anything, "app-uuid").Return(nil)
+	sacClient.On("FindApplicationByName", mock.Anything, "app").Return(&dto.ApplicationDTO{}, ErrorNotFound)
+	cache := NewInventoryCache("staging", sacClient, time.Minute)
+	_, err := cache.FindApplicationByName(context.Background(), "app")
+	require.NoError(t, err)
+
+	// when
+	err = cache.DeleteApplication(context.Background(), "app-uuid")
+
+	// then the deleted application is looked up again
+	require.NoError(t, err)
+	_, err = cache.FindApplicationByName(context.Background(), "app")
+	assert.ErrorIs(t, err, ErrorNotFound)
+	sacClient.AssertExpectations(t)
+}
+
+func TestInventoryCache_DeletedOutsideTheCluster(t *testing.T) {
+	// given
+	sacClient := &MockSecureAccessCloudClient{}
+	sacClient.On("ListApplications", mock.Anything).Return([]dto.ApplicationDTO{{ID: "app-uuid", Name: "app"}}, nil).Once()
+	sacClient.On("FindApplicationByID", mock.Anything, "app-uuid").Return(&dto.ApplicationDTO{}, ErrorNotFound)
+	sacClient.On("FindApplicationByName", mock.Anything, "app").Return(&dto.ApplicationDTO{}, ErrorNotFound)
+	cache := NewInventoryCache("staging", sacClient, time.Minute)
+	_, err := cache.FindApplicationByName(context.Background(), "app")
+	require.NoError(t, err)
+
+	// when
+	_, err = cache.FindApplicationByID(context.Background(), "app-uuid")
+
+	// then the application is not found by name anymore, so that it can be created again
+	assert.ErrorIs(t, err, ErrorNotFound)
+	_, err = cache.FindApplicationByName(context.Background(), "app")
+	assert.ErrorIs(t, err, ErrorNotFound)
+	sacClient.AssertExpectations(t)
+}
+
+func TestInventoryCache_Identity(t *testing.T) {
+	// given
+	sacClient := &MockSecureAccessCloudClient{}
+	sacClient.On("ListSites", mock.Anything).Return([]dto.SiteDTO{{ID: "site-uuid", Name: "prod", Connectors: []string{"connector-uuid"}, ApplicationIDs: []string{"app-uuid"}}}, nil)
+	sacClient.On("ListPolicies", mock.Anything).Return([]dto.PolicyDTO{{ID: "policy-uuid", Name: "admins", Type: "ACCESS", Enabled: true}}, nil)
+	cache := NewInventoryCache("staging", sacClient, time.Minute)
+
+	// when
+	site, siteErr := cache.FindSiteByName(context.Background(), "prod")
+	policy, policyErr := cache.FindPolicyByName(context.Background(), model.AccessPolicy, "admins")
+
+	// then the content, changed by the bindings and the connectors, is not cached
+	require.NoError(t, siteErr)
+	require.NoError(t, policyErr)
+	assert.Equal(t, &dto.SiteDTO{ID: "site-uuid", Name: "prod"}, site)
+	assert.Equal(t, dto.PolicyDTO{ID: "policy-uuid", Name: "admins", Type: "ACCESS"}, policy)
+}
+
+func TestInventoryCache_TTL(t *testing.T) {
+	// given
+	sacClient := &MockSecureAccessCloudClient{}
+	sacClient.On("ListPolicies", mock.Anything).Return([]dto.PolicyDTO{{ID: "policy-uuid", Name: "admins", Type: "ACCESS"}}, nil).Twice()
+	cache := NewInventoryCache("staging", sacClient, 10*time.Millisecond)
+	_, err := cache.FindPolicyByName(context.Background(), model.AccessPolicy, "admins")
+	require.NoError(t, err)
+
+	// when the index expired
+	time.Sleep(20 * time.Millisecond)
+	_, err = cache.FindPolicyByName(context.Background(), model.AccessPolicy, "admins")
+
+	// then the policies are listed again
+	assert.NoError(t, err)
+	sacClient.AssertNumberOfCalls(t, "ListPolicies", 2)
+}
+
+func TestInventoryCache_ConcurrentLookups(t *testing.T) {
+	// given
+	sacClient := &MockSecureAccessCloudClient{}
+	sacClient.On("ListSites", mock.Anything).After(20*time.Millisecond).Return([]dto.SiteDTO{{ID: "site-uuid", Name: "prod"}}, nil)
+	cache := NewInventoryCache("staging", sacClient, time.Minute)
+
+	// when
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			site, err := cache.FindSiteByName(context.Background(), "prod")
+			assert.NoError(t, err)
+			assert.Equal(t, "site-uuid", site.ID)
+		}()
+	}
+	wg.Wait()
+
+	// then the sites are listed once
+	sacClient.AssertNumberOfCalls(t, "ListSites", 1)
+}
+
+func TestInventoryCache_FindPolicyByName_Type(t *testing.T) {
+	// given an access policy and an activity policy of the same name
+	sacClient := &MockSecureAccessCloudClient{}
+	sacClient.On("ListPolicies", mock.Anything).Return([]dto.PolicyDTO{
+		{ID: "access-policy-uuid", Name: "devops", Type: "ACCESS"},
+		{ID: "activity-policy-uuid", Name: "devops", Type: "ACTIVITY"},
+	}, nil)
+	cache := NewInventoryCache("staging", sacClient, time.Minute)
+
+	// when
+	accessPolicy, accessErr := cache.FindPolicyByName(context.Background(), model.AccessPolicy, "devops")
+	activityPolicy, activityErr := cache.FindPolicyByName(context.Background(), model.ActivityPolicy, "devops")
+
+	// then each lookup finds the policy of its type
+	require.NoError(t, accessErr)
+	require.NoError(t, activityErr)
+	assert.Equal(t, "access-policy-uuid", accessPolicy.ID)
+	assert.Equal(t, "activity-policy-uuid", activityPolicy.ID)
+	sacClient.AssertNotCalled(t, "FindPolicyByName", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,84 @@
+package sac
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// credentialsGeneration exposes the generation of the credentials in use by the client of every tenant. The
+// generation is incremented every time the tenant credentials change.
+var credentialsGeneration = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "sac_tenant_credentials_generation",
+		Help: "The generation of the Secure-Access-Cloud credentials in use by the tenant client",
+	},
+	[]string{"tenant"},
+)
+
+// requestAttempts exposes the number of attempts made by the requests to Secure-Access-Cloud, including the retries.
+var requestAttempts = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "sac_client_request_attempts",
+		Help:    "The number of attempts made by a request to Secure-Access-Cloud",
+		Buckets: []float64{1, 2, 3, 4, 6, 8},
+	},
+	[]string{"method"},
+)
+
+// requestRetries exposes the retries of the requests to Secure-Access-Cloud by the reason of the retry.
+var requestRetries = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "sac_client_request_retries_total",
+		Help: "The number of retried requests to Secure-Access-Cloud",
+	},
+	[]string{"method", "reason"},
+)
+
+// rateLimiterDelay exposes the time the requests to Secure-Access-Cloud waited for the tenant rate limiter.
+var rateLimiterDelay = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "sac_client_rate_limiter_delay_seconds",
+		Help:    "The time a request to Secure-Access-Cloud waited for the tenant rate limiter",
+		Buckets: []float64{0.001, 0.01, 0.1, 0.5, 1, 2.5, 5, 10, 30},
+	},
+	[]string{"tenant", "priority"},
+)
+
+// rateLimiterQueued exposes the number of requests to Secure-Access-Cloud currently waiting for the tenant rate limiter.
+var rateLimiterQueued = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "sac_client_rate_limiter_queued_requests",
+		Help: "The number of requests to Secure-Access-Cloud waiting for the tenant rate limiter",
+	},
+	[]string{"tenant", "priority"},
+)
+
+// inventoryLookups exposes the lookups by name of the inventory cache by whether they were answered from the cache.
+var inventoryLookups = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "sac_inventory_lookups_total",
+		Help: "The number of lookups by name of Secure-Access-Cloud objects by whether the inventory cache held the name",
+	},
+	[]string{"tenant", "kind", "result"},
+)
+
+// inventorySize exposes the number of objects listed by the last listing of the inventory cache.
+var inventorySize = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "sac_inventory_objects",
+		Help: "The number of Secure-Access-Cloud objects listed by the inventory cache",
+	},
+	[]string{"tenant", "kind"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(credentialsGeneration, requestAttempts, requestRetries, rateLimiterDelay, rateLimiterQueued,
+		inventoryLookups, inventorySize)
+}
+
+func tenantMetricLabel(tenant string) string {
+	if tenant == DefaultTenant {
+		return "default"
+	}
+	return tenant
+}
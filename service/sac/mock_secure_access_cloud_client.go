@@ -3,6 +3,8 @@
 package sac
 
 import (
+	context "context"
+
 	dto "bitbucket.org/accezz-io/sac-operator/service/sac/dto"
 	mock "github.com/stretchr/testify/mock"
 
@@ -14,13 +16,13 @@ type MockSecureAccessCloudClient struct {
 	mock.Mock
 }
 
-// BindApplicationToSite provides a mock function with given fields: applicationId, siteId
-func (_m *MockSecureAccessCloudClient) BindApplicationToSite(applicationId string, siteId string) error {
-	ret := _m.Called(applicationId, siteId)
+// BindApplicationToSite provides a mock function with given fields: ctx, applicationId, siteId
+func (_m *MockSecureAccessCloudClient) BindApplicationToSite(ctx context.Context, applicationId string, siteId string) error {
+	ret := _m.Called(ctx, applicationId, siteId)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(applicationId, siteId)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, applicationId, siteId)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -28,13 +30,13 @@ func (_m *MockSecureAccessCloudClient) BindApplicationToSite(applicationId strin
 	return r0
 }
 
-// CreateApplication provides a mock function with given fields: applicationDTO
-func (_m *MockSecureAccessCloudClient) CreateApplication(applicationDTO *dto.ApplicationDTO) (*dto.ApplicationDTO, error) {
-	ret := _m.Called(applicationDTO)
+// CreateApplication provides a mock function with given fields: ctx, applicationDTO
+func (_m *MockSecureAccessCloudClient) CreateApplication(ctx context.Context, applicationDTO *dto.ApplicationDTO) (*dto.ApplicationDTO, error) {
+	ret := _m.Called(ctx, applicationDTO)
 
 	var r0 *dto.ApplicationDTO
-	if rf, ok := ret.Get(0).(func(*dto.ApplicationDTO) *dto.ApplicationDTO); ok {
-		r0 = rf(applicationDTO)
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ApplicationDTO) *dto.ApplicationDTO); ok {
+		r0 = rf(ctx, applicationDTO)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.ApplicationDTO)
@@ -42,8 +44,8 @@ func (_m *MockSecureAccessCloudClient) CreateApplication(applicationDTO *dto.App
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dto.ApplicationDTO) error); ok {
-		r1 = rf(applicationDTO)
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.ApplicationDTO) error); ok {
+		r1 = rf(ctx, applicationDTO)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -51,13 +53,13 @@ func (_m *MockSecureAccessCloudClient) CreateApplication(applicationDTO *dto.App
 	return r0, r1
 }
 
-// CreateConnector provides a mock function with given fields: siteDTO, connectorName
-func (_m *MockSecureAccessCloudClient) CreateConnector(siteDTO *dto.SiteDTO, connectorName string) (*dto.ConnectorObjects, error) {
-	ret := _m.Called(siteDTO, connectorName)
+// CreateConnector provides a mock function with given fields: ctx, siteDTO, connectorName
+func (_m *MockSecureAccessCloudClient) CreateConnector(ctx context.Context, siteDTO *dto.SiteDTO, connectorName string) (*dto.ConnectorObjects, error) {
+	ret := _m.Called(ctx, siteDTO, connectorName)
 
 	var r0 *dto.ConnectorObjects
-	if rf, ok := ret.Get(0).(func(*dto.SiteDTO, string) *dto.ConnectorObjects); ok {
-		r0 = rf(siteDTO, connectorName)
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.SiteDTO, string) *dto.ConnectorObjects); ok {
+		r0 = rf(ctx, siteDTO, connectorName)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.ConnectorObjects)
@@ -65,8 +67,31 @@ func (_m *MockSecureAccessCloudClient) CreateConnector(siteDTO *dto.SiteDTO, con
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dto.SiteDTO, string) error); ok {
-		r1 = rf(siteDTO, connectorName)
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.SiteDTO, string) error); ok {
+		r1 = rf(ctx, siteDTO, connectorName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreatePolicy provides a mock function with given fields: ctx, policyDTO
+func (_m *MockSecureAccessCloudClient) CreatePolicy(ctx context.Context, policyDTO *dto.PolicyDTO) (*dto.PolicyDTO, error) {
+	ret := _m.Called(ctx, policyDTO)
+
+	var r0 *dto.PolicyDTO
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.PolicyDTO) *dto.PolicyDTO); ok {
+		r0 = rf(ctx, policyDTO)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PolicyDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.PolicyDTO) error); ok {
+		r1 = rf(ctx, policyDTO)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -74,13 +99,13 @@ func (_m *MockSecureAccessCloudClient) CreateConnector(siteDTO *dto.SiteDTO, con
 	return r0, r1
 }
 
-// CreateSite provides a mock function with given fields: siteDTO
-func (_m *MockSecureAccessCloudClient) CreateSite(siteDTO *dto.SiteDTO) (*dto.SiteDTO, error) {
-	ret := _m.Called(siteDTO)
+// CreateSite provides a mock function with given fields: ctx, siteDTO
+func (_m *MockSecureAccessCloudClient) CreateSite(ctx context.Context, siteDTO *dto.SiteDTO) (*dto.SiteDTO, error) {
+	ret := _m.Called(ctx, siteDTO)
 
 	var r0 *dto.SiteDTO
-	if rf, ok := ret.Get(0).(func(*dto.SiteDTO) *dto.SiteDTO); ok {
-		r0 = rf(siteDTO)
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.SiteDTO) *dto.SiteDTO); ok {
+		r0 = rf(ctx, siteDTO)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.SiteDTO)
@@ -88,8 +113,8 @@ func (_m *MockSecureAccessCloudClient) CreateSite(siteDTO *dto.SiteDTO) (*dto.Si
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dto.SiteDTO) error); ok {
-		r1 = rf(siteDTO)
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.SiteDTO) error); ok {
+		r1 = rf(ctx, siteDTO)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -97,13 +122,27 @@ func (_m *MockSecureAccessCloudClient) CreateSite(siteDTO *dto.SiteDTO) (*dto.Si
 	return r0, r1
 }
 
-// DeleteApplication provides a mock function with given fields: id
-func (_m *MockSecureAccessCloudClient) DeleteApplication(id string) error {
-	ret := _m.Called(id)
+// DeleteApplication provides a mock function with given fields: ctx, id
+func (_m *MockSecureAccessCloudClient) DeleteApplication(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteConnector provides a mock function with given fields: ctx, connectorID
+func (_m *MockSecureAccessCloudClient) DeleteConnector(ctx context.Context, connectorID string) error {
+	ret := _m.Called(ctx, connectorID)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, connectorID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -111,13 +150,13 @@ func (_m *MockSecureAccessCloudClient) DeleteApplication(id string) error {
 	return r0
 }
 
-// DeleteConnector provides a mock function with given fields: connectorID
-func (_m *MockSecureAccessCloudClient) DeleteConnector(connectorID string) error {
-	ret := _m.Called(connectorID)
+// DeletePolicy provides a mock function with given fields: ctx, id
+func (_m *MockSecureAccessCloudClient) DeletePolicy(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(connectorID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -125,13 +164,13 @@ func (_m *MockSecureAccessCloudClient) DeleteConnector(connectorID string) error
 	return r0
 }
 
-// DeleteSite provides a mock function with given fields: id
-func (_m *MockSecureAccessCloudClient) DeleteSite(id string) error {
-	ret := _m.Called(id)
+// DeleteSite provides a mock function with given fields: ctx, id
+func (_m *MockSecureAccessCloudClient) DeleteSite(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -139,13 +178,13 @@ func (_m *MockSecureAccessCloudClient) DeleteSite(id string) error {
 	return r0
 }
 
-// FindApplicationByID provides a mock function with given fields: id
-func (_m *MockSecureAccessCloudClient) FindApplicationByID(id string) (*dto.ApplicationDTO, error) {
-	ret := _m.Called(id)
+// FindApplicationByID provides a mock function with given fields: ctx, id
+func (_m *MockSecureAccessCloudClient) FindApplicationByID(ctx context.Context, id string) (*dto.ApplicationDTO, error) {
+	ret := _m.Called(ctx, id)
 
 	var r0 *dto.ApplicationDTO
-	if rf, ok := ret.Get(0).(func(string) *dto.ApplicationDTO); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.ApplicationDTO); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.ApplicationDTO)
@@ -153,8 +192,8 @@ func (_m *MockSecureAccessCloudClient) FindApplicationByID(id string) (*dto.Appl
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -162,13 +201,13 @@ func (_m *MockSecureAccessCloudClient) FindApplicationByID(id string) (*dto.Appl
 	return r0, r1
 }
 
-// FindApplicationByName provides a mock function with given fields: name
-func (_m *MockSecureAccessCloudClient) FindApplicationByName(name string) (*dto.ApplicationDTO, error) {
-	ret := _m.Called(name)
+// FindApplicationByName provides a mock function with given fields: ctx, name
+func (_m *MockSecureAccessCloudClient) FindApplicationByName(ctx context.Context, name string) (*dto.ApplicationDTO, error) {
+	ret := _m.Called(ctx, name)
 
 	var r0 *dto.ApplicationDTO
-	if rf, ok := ret.Get(0).(func(string) *dto.ApplicationDTO); ok {
-		r0 = rf(name)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.ApplicationDTO); ok {
+		r0 = rf(ctx, name)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.ApplicationDTO)
@@ -176,8 +215,8 @@ func (_m *MockSecureAccessCloudClient) FindApplicationByName(name string) (*dto.
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(name)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -185,13 +224,13 @@ func (_m *MockSecureAccessCloudClient) FindApplicationByName(name string) (*dto.
 	return r0, r1
 }
 
-// FindPoliciesByNames provides a mock function with given fields: name
-func (_m *MockSecureAccessCloudClient) FindPoliciesByNames(name []string) ([]dto.PolicyDTO, error) {
-	ret := _m.Called(name)
+// FindPoliciesByNames provides a mock function with given fields: ctx, references
+func (_m *MockSecureAccessCloudClient) FindPoliciesByNames(ctx context.Context, references []model.PolicyReference) ([]dto.PolicyDTO, error) {
+	ret := _m.Called(ctx, references)
 
 	var r0 []dto.PolicyDTO
-	if rf, ok := ret.Get(0).(func([]string) []dto.PolicyDTO); ok {
-		r0 = rf(name)
+	if rf, ok := ret.Get(0).(func(context.Context, []model.PolicyReference) []dto.PolicyDTO); ok {
+		r0 = rf(ctx, references)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]dto.PolicyDTO)
@@ -199,8 +238,31 @@ func (_m *MockSecureAccessCloudClient) FindPoliciesByNames(name []string) ([]dto
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]string) error); ok {
-		r1 = rf(name)
+	if rf, ok := ret.Get(1).(func(context.Context, []model.PolicyReference) error); ok {
+		r1 = rf(ctx, references)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindPolicyByID provides a mock function with given fields: ctx, id
+func (_m *MockSecureAccessCloudClient) FindPolicyByID(ctx context.Context, id string) (*dto.PolicyDTO, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *dto.PolicyDTO
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.PolicyDTO); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PolicyDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -208,20 +270,43 @@ func (_m *MockSecureAccessCloudClient) FindPoliciesByNames(name []string) ([]dto
 	return r0, r1
 }
 
-// FindPolicyByName provides a mock function with given fields: name
-func (_m *MockSecureAccessCloudClient) FindPolicyByName(name string) (dto.PolicyDTO, error) {
-	ret := _m.Called(name)
+// FindPolicyByName provides a mock function with given fields: ctx, policyType, name
+func (_m *MockSecureAccessCloudClient) FindPolicyByName(ctx context.Context, policyType model.PolicyType, name string) (dto.PolicyDTO, error) {
+	ret := _m.Called(ctx, policyType, name)
 
 	var r0 dto.PolicyDTO
-	if rf, ok := ret.Get(0).(func(string) dto.PolicyDTO); ok {
-		r0 = rf(name)
+	if rf, ok := ret.Get(0).(func(context.Context, model.PolicyType, string) dto.PolicyDTO); ok {
+		r0 = rf(ctx, policyType, name)
 	} else {
 		r0 = ret.Get(0).(dto.PolicyDTO)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(name)
+	if rf, ok := ret.Get(1).(func(context.Context, model.PolicyType, string) error); ok {
+		r1 = rf(ctx, policyType, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindSiteByID provides a mock function with given fields: ctx, id
+func (_m *MockSecureAccessCloudClient) FindSiteByID(ctx context.Context, id string) (*dto.SiteDTO, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *dto.SiteDTO
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.SiteDTO); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SiteDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -229,13 +314,13 @@ func (_m *MockSecureAccessCloudClient) FindPolicyByName(name string) (dto.Policy
 	return r0, r1
 }
 
-// FindSiteByName provides a mock function with given fields: name
-func (_m *MockSecureAccessCloudClient) FindSiteByName(name string) (*dto.SiteDTO, error) {
-	ret := _m.Called(name)
+// FindSiteByName provides a mock function with given fields: ctx, name
+func (_m *MockSecureAccessCloudClient) FindSiteByName(ctx context.Context, name string) (*dto.SiteDTO, error) {
+	ret := _m.Called(ctx, name)
 
 	var r0 *dto.SiteDTO
-	if rf, ok := ret.Get(0).(func(string) *dto.SiteDTO); ok {
-		r0 = rf(name)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.SiteDTO); ok {
+		r0 = rf(ctx, name)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.SiteDTO)
@@ -243,8 +328,8 @@ func (_m *MockSecureAccessCloudClient) FindSiteByName(name string) (*dto.SiteDTO
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(name)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -252,13 +337,13 @@ func (_m *MockSecureAccessCloudClient) FindSiteByName(name string) (*dto.SiteDTO
 	return r0, r1
 }
 
-// GetConnectorDeploymentCommand provides a mock function with given fields: connectorID
-func (_m *MockSecureAccessCloudClient) GetConnectorDeploymentCommand(connectorID string) (*dto.ConnectorDeploymentCommand, error) {
-	ret := _m.Called(connectorID)
+// GetConnectorDeploymentCommand provides a mock function with given fields: ctx, connectorID
+func (_m *MockSecureAccessCloudClient) GetConnectorDeploymentCommand(ctx context.Context, connectorID string) (*dto.ConnectorDeploymentCommand, error) {
+	ret := _m.Called(ctx, connectorID)
 
 	var r0 *dto.ConnectorDeploymentCommand
-	if rf, ok := ret.Get(0).(func(string) *dto.ConnectorDeploymentCommand); ok {
-		r0 = rf(connectorID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *dto.ConnectorDeploymentCommand); ok {
+		r0 = rf(ctx, connectorID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.ConnectorDeploymentCommand)
@@ -266,8 +351,31 @@ func (_m *MockSecureAccessCloudClient) GetConnectorDeploymentCommand(connectorID
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(connectorID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, connectorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListApplications provides a mock function with given fields: ctx
+func (_m *MockSecureAccessCloudClient) ListApplications(ctx context.Context) ([]dto.ApplicationDTO, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []dto.ApplicationDTO
+	if rf, ok := ret.Get(0).(func(context.Context) []dto.ApplicationDTO); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ApplicationDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -275,13 +383,13 @@ func (_m *MockSecureAccessCloudClient) GetConnectorDeploymentCommand(connectorID
 	return r0, r1
 }
 
-// ListConnectorsBySite provides a mock function with given fields: siteName
-func (_m *MockSecureAccessCloudClient) ListConnectorsBySite(siteName string) ([]string, error) {
-	ret := _m.Called(siteName)
+// ListConnectorsBySite provides a mock function with given fields: ctx, siteName
+func (_m *MockSecureAccessCloudClient) ListConnectorsBySite(ctx context.Context, siteName string) ([]string, error) {
+	ret := _m.Called(ctx, siteName)
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func(string) []string); ok {
-		r0 = rf(siteName)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, siteName)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -289,8 +397,31 @@ func (_m *MockSecureAccessCloudClient) ListConnectorsBySite(siteName string) ([]
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(siteName)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, siteName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPolicies provides a mock function with given fields: ctx
+func (_m *MockSecureAccessCloudClient) ListPolicies(ctx context.Context) ([]dto.PolicyDTO, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []dto.PolicyDTO
+	if rf, ok := ret.Get(0).(func(context.Context) []dto.PolicyDTO); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.PolicyDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -298,13 +429,36 @@ func (_m *MockSecureAccessCloudClient) ListConnectorsBySite(siteName string) ([]
 	return r0, r1
 }
 
-// UpdateApplication provides a mock function with given fields: applicationDTO
-func (_m *MockSecureAccessCloudClient) UpdateApplication(applicationDTO *dto.ApplicationDTO) (*dto.ApplicationDTO, error) {
-	ret := _m.Called(applicationDTO)
+// ListSites provides a mock function with given fields: ctx
+func (_m *MockSecureAccessCloudClient) ListSites(ctx context.Context) ([]dto.SiteDTO, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []dto.SiteDTO
+	if rf, ok := ret.Get(0).(func(context.Context) []dto.SiteDTO); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.SiteDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateApplication provides a mock function with given fields: ctx, applicationDTO
+func (_m *MockSecureAccessCloudClient) UpdateApplication(ctx context.Context, applicationDTO *dto.ApplicationDTO) (*dto.ApplicationDTO, error) {
+	ret := _m.Called(ctx, applicationDTO)
 
 	var r0 *dto.ApplicationDTO
-	if rf, ok := ret.Get(0).(func(*dto.ApplicationDTO) *dto.ApplicationDTO); ok {
-		r0 = rf(applicationDTO)
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ApplicationDTO) *dto.ApplicationDTO); ok {
+		r0 = rf(ctx, applicationDTO)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.ApplicationDTO)
@@ -312,8 +466,8 @@ func (_m *MockSecureAccessCloudClient) UpdateApplication(applicationDTO *dto.App
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dto.ApplicationDTO) error); ok {
-		r1 = rf(applicationDTO)
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.ApplicationDTO) error); ok {
+		r1 = rf(ctx, applicationDTO)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -321,16 +475,39 @@ func (_m *MockSecureAccessCloudClient) UpdateApplication(applicationDTO *dto.App
 	return r0, r1
 }
 
-// UpdatePolicies provides a mock function with given fields: applicationId, applicationType, policies
-func (_m *MockSecureAccessCloudClient) UpdatePolicies(applicationId string, applicationType model.ApplicationType, policies []string) error {
-	ret := _m.Called(applicationId, applicationType, policies)
+// UpdatePolicies provides a mock function with given fields: ctx, applicationId, applicationType, policies
+func (_m *MockSecureAccessCloudClient) UpdatePolicies(ctx context.Context, applicationId string, applicationType model.ApplicationType, policies []string) error {
+	ret := _m.Called(ctx, applicationId, applicationType, policies)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, model.ApplicationType, []string) error); ok {
-		r0 = rf(applicationId, applicationType, policies)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ApplicationType, []string) error); ok {
+		r0 = rf(ctx, applicationId, applicationType, policies)
 	} else {
 		r0 = ret.Error(0)
 	}
 
 	return r0
 }
+
+// UpdatePolicy provides a mock function with given fields: ctx, policyDTO
+func (_m *MockSecureAccessCloudClient) UpdatePolicy(ctx context.Context, policyDTO *dto.PolicyDTO) (*dto.PolicyDTO, error) {
+	ret := _m.Called(ctx, policyDTO)
+
+	var r0 *dto.PolicyDTO
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.PolicyDTO) *dto.PolicyDTO); ok {
+		r0 = rf(ctx, policyDTO)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.PolicyDTO)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.PolicyDTO) error); ok {
+		r1 = rf(ctx, policyDTO)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
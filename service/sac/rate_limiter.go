@@ -0,0 +1,179 @@
+package sac
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RequestPriority is the priority of a request to Secure-Access-Cloud when it waits for the rate limiter.
+type RequestPriority int
+
+const (
+	// PriorityRoutine is the priority of the requests made by the routine reconciliation of the objects.
+	PriorityRoutine RequestPriority = iota
+	// PriorityHigh is the priority of the deletions, which block the removal of the objects finalizers.
+	PriorityHigh
+
+	numberOfPriorities = 2
+)
+
+func (p RequestPriority) String() string {
+	if p == PriorityHigh {
+		return "high"
+	}
+	return "routine"
+}
+
+type priorityKey struct{}
+
+// WithPriority returns a copy of the context whose requests to Secure-Access-Cloud wait for the rate limiter with the
+// given priority.
+func WithPriority(ctx context.Context, priority RequestPriority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// requestPriority returns the priority of a request. The DELETE requests always have the high priority, the other
+// requests have the priority of their context, routine by default.
+func requestPriority(ctx context.Context, method string) RequestPriority {
+	if method == http.MethodDelete {
+		return PriorityHigh
+	}
+	if priority, ok := ctx.Value(priorityKey{}).(RequestPriority); ok {
+		return priority
+	}
+	return PriorityRoutine
+}
+
+// RateLimiter is a token bucket limiting the rate of the requests made to a Secure-Access-Cloud tenant. The waiting
+// requests are granted in the order of their priority, then in their arrival order.
+type RateLimiter struct {
+	tenant string
+	limit  float64
+	burst  float64
+
+	mu      sync.Mutex
+	tokens  float64
+	last    time.Time
+	waiters [numberOfPriorities][]*waiter
+	timer   *time.Timer
+}
+
+type waiter struct {
+	granted chan struct{}
+}
+
+// NewRateLimiter returns a limiter allowing limit requests per second to the tenant, with bursts of up to burst
+// requests. The bucket starts full.
+func NewRateLimiter(tenant string, limit float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{tenant: tenant, limit: limit, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// Wait blocks until a request of the given priority is allowed, or the context is done. A nil limiter allows all the
+// requests.
+func (l *RateLimiter) Wait(ctx context.Context, priority RequestPriority) error {
+	if l == nil {
+		return nil
+	}
+	start := time.Now()
+
+	l.mu.Lock()
+	l.refill(start)
+	if l.tokens >= 1 && !l.hasWaiters(priority) {
+		l.tokens--
+		l.mu.Unlock()
+		l.observe(priority, start)
+		return nil
+	}
+
+	w := &waiter{granted: make(chan struct{})}
+	l.waiters[priority] = append(l.waiters[priority], w)
+	rateLimiterQueued.WithLabelValues(tenantMetricLabel(l.tenant), priority.String()).Inc()
+	l.schedule()
+	l.mu.Unlock()
+
+	select {
+	case <-w.granted:
+		l.observe(priority, start)
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		select {
+		case <-w.granted:
+			// the token was granted meanwhile, give it back to the next waiter
+			l.tokens++
+			l.dispatch()
+		default:
+			l.remove(priority, w)
+		}
+		return ctx.Err()
+	}
+}
+
+// hasWaiters returns whether requests of the given priority, or of a higher priority, are already waiting.
+func (l *RateLimiter) hasWaiters(priority RequestPriority) bool {
+	for p := priority; p < numberOfPriorities; p++ {
+		if len(l.waiters[p]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *RateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(l.last).Seconds()
+	if elapsed > 0 {
+		l.tokens = math.Min(l.burst, l.tokens+elapsed*l.limit)
+		l.last = now
+	}
+}
+
+// dispatch grants the available tokens to the waiters, highest priority first, and schedules the next dispatch when
+// waiters remain.
+func (l *RateLimiter) dispatch() {
+	l.refill(time.Now())
+	for p := numberOfPriorities - 1; p >= 0; p-- {
+		for len(l.waiters[p]) > 0 && l.tokens >= 1 {
+			w := l.waiters[p][0]
+			l.waiters[p] = l.waiters[p][1:]
+			l.tokens--
+			rateLimiterQueued.WithLabelValues(tenantMetricLabel(l.tenant), RequestPriority(p).String()).Dec()
+			close(w.granted)
+		}
+	}
+	l.schedule()
+}
+
+// schedule arms the timer dispatching the next token when requests are waiting and no dispatch is scheduled yet.
+func (l *RateLimiter) schedule() {
+	if l.timer != nil || !l.hasWaiters(PriorityRoutine) {
+		return
+	}
+	delay := time.Duration((1 - l.tokens) / l.limit * float64(time.Second))
+	l.timer = time.AfterFunc(delay, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.timer = nil
+		l.dispatch()
+	})
+}
+
+func (l *RateLimiter) remove(priority RequestPriority, w *waiter) {
+	for i, queued := range l.waiters[priority] {
+		if queued == w {
+			l.waiters[priority] = append(l.waiters[priority][:i], l.waiters[priority][i+1:]...)
+			rateLimiterQueued.WithLabelValues(tenantMetricLabel(l.tenant), priority.String()).Dec()
+			return
+		}
+	}
+}
+
+func (l *RateLimiter) observe(priority RequestPriority, start time.Time) {
+	rateLimiterDelay.WithLabelValues(tenantMetricLabel(l.tenant), priority.String()).Observe(time.Since(start).Seconds())
+}
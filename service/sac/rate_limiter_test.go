@@ -0,0 +1,126 @@
+package sac
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestPriority(t *testing.T) {
+	tests := []struct {
+		name             string
+		ctx              context.Context
+		method           string
+		expectedPriority RequestPriority
+	}{
+		{
+			name:             "routine by default",
+			ctx:              context.Background(),
+			method:           http.MethodPut,
+			expectedPriority: PriorityRoutine,
+		},
+		{
+			name:             "delete",
+			ctx:              context.Background(),
+			method:           http.MethodDelete,
+			expectedPriority: PriorityHigh,
+		},
+		{
+			name:             "priority of the context",
+			ctx:              WithPriority(context.Background(), PriorityHigh),
+			method:           http.MethodGet,
+			expectedPriority: PriorityHigh,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			priority := requestPriority(tt.ctx, tt.method)
+
+			// then
+			assert.Equal(t, tt.expectedPriority, priority)
+		})
+	}
+}
+
+func TestRateLimiter_Burst(t *testing.T) {
+	// given
+	limiter := NewRateLimiter("burst", 1, 3)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// when the burst is used, then the requests are allowed at once
+	for i := 0; i < 3; i++ {
+		require.NoError(t, limiter.Wait(ctx, PriorityRoutine))
+	}
+
+	// when the bucket is empty, then the request waits for the next token
+	assert.ErrorIs(t, limiter.Wait(ctx, PriorityRoutine), context.DeadlineExceeded)
+	assert.Empty(t, limiter.waiters[PriorityRoutine])
+}
+
+func TestRateLimiter_Priority(t *testing.T) {
+	// given an empty bucket and queued routine requests
+	limiter := NewRateLimiter("priority", 5, 1)
+	require.NoError(t, limiter.Wait(context.Background(), PriorityRoutine))
+
+	var mu sync.Mutex
+	var order []RequestPriority
+	var wg sync.WaitGroup
+	wait := func(priority RequestPriority) {
+		defer wg.Done()
+		assert.NoError(t, limiter.Wait(context.Background(), priority))
+		mu.Lock()
+		order = append(order, priority)
+		mu.Unlock()
+	}
+
+	wg.Add(2)
+	go wait(PriorityRoutine)
+	go wait(PriorityRoutine)
+	assert.Eventually(t, func() bool {
+		limiter.mu.Lock()
+		defer limiter.mu.Unlock()
+		return len(limiter.waiters[PriorityRoutine]) == 2
+	}, time.Second, time.Millisecond)
+
+	// when a high priority request arrives
+	wg.Add(1)
+	go wait(PriorityHigh)
+	wg.Wait()
+
+	// then it is granted before the routine requests
+	assert.Equal(t, []RequestPriority{PriorityHigh, PriorityRoutine, PriorityRoutine}, order)
+}
+
+func TestRateLimiter_Nil(t *testing.T) {
+	// given
+	var limiter *RateLimiter
+
+	// when
+	err := limiter.Wait(context.Background(), PriorityRoutine)
+
+	// then
+	assert.NoError(t, err)
+}
+
+func TestSecureAccessCloudClientRegistry_RateLimit(t *testing.T) {
+	// given
+	registry := NewSecureAccessCloudClientRegistry().SetRateLimit(5, 10)
+	settings := SecureAccessCloudSettings{ClientID: "id", ClientSecret: "secret", TenantDomain: "staging.example.com"}
+	client := registry.Register("staging", settings).(*SecureAccessCloudClientImpl)
+
+	// when the credentials are rotated
+	settings.ClientSecret = "rotated-secret"
+	rotated := registry.Register("staging", settings).(*SecureAccessCloudClientImpl)
+
+	// then the limiter of the tenant is kept
+	assert.NotNil(t, client.Limiter)
+	assert.Same(t, client.Limiter, rotated.Limiter)
+	assert.NotSame(t, client.Limiter, registry.Register("prod", settings).(*SecureAccessCloudClientImpl).Limiter)
+}
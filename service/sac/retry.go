@@ -0,0 +1,109 @@
+package sac
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"gopkg.in/resty.v1"
+)
+
+// RetryPolicy defines how the failed requests to Secure-Access-Cloud are retried.
+type RetryPolicy struct {
+	// The maximum number of attempts of a request, including the first attempt.
+	MaxAttempts int
+	// The delay before the first retry, doubled for every following retry.
+	InitialBackoff time.Duration
+	// The maximum delay before a retry. A request whose Retry-After is longer is not retried.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is the retry policy of the clients created by NewSecureAccessCloudClientImpl.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     30 * time.Second,
+}
+
+const (
+	retryReasonConnection  = "connection"
+	retryReasonRateLimited = "rate_limited"
+	retryReasonUnavailable = "unavailable"
+)
+
+// retryReason classifies the result of an attempt and returns the reason to retry it, or an empty reason when the
+// attempt succeeded or failed permanently. A rate limited request was not processed, so it is retried whatever its
+// method, while the other failures are only retried for the idempotent methods.
+func retryReason(method string, response *resty.Response, err error) string {
+	if err != nil {
+		if isIdempotent(method) && isConnectionError(err) {
+			return retryReasonConnection
+		}
+		return ""
+	}
+
+	switch response.StatusCode() {
+	case http.StatusTooManyRequests:
+		return retryReasonRateLimited
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		if isIdempotent(method) {
+			return retryReasonUnavailable
+		}
+	}
+	return ""
+}
+
+func isIdempotent(method string) bool {
+	return method != http.MethodPost && method != http.MethodPatch
+}
+
+func isConnectionError(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// delay returns the delay before retrying the given failed attempt: the Retry-After of the response when there is
+// one, otherwise an exponential backoff with jitter. It returns false when the Retry-After is longer than the
+// maximum backoff, the request is then left to be retried by the next reconcile.
+func (p RetryPolicy) delay(attempt int, response *resty.Response) (time.Duration, bool) {
+	if retryAfter, ok := parseRetryAfter(response); ok {
+		return retryAfter, retryAfter <= p.MaxBackoff
+	}
+
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	// jitter over the upper half of the backoff, so concurrent reconciles do not retry together
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1)), true
+}
+
+// parseRetryAfter parses the Retry-After header of the response, either a number of seconds or an http date.
+func parseRetryAfter(response *resty.Response) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+	value := response.Header().Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
@@ -0,0 +1,185 @@
+package sac
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"gopkg.in/resty.v1"
+)
+
+type roundTripperFunc func(request *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+func testResponse(statusCode int, header http.Header) *resty.Response {
+	return &resty.Response{RawResponse: &http.Response{StatusCode: statusCode, Header: header}}
+}
+
+func TestRetryReason(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		response       *resty.Response
+		err            error
+		expectedReason string
+	}{
+		{
+			name:     "success",
+			method:   http.MethodGet,
+			response: testResponse(http.StatusOK, nil),
+		},
+		{
+			name:     "not found",
+			method:   http.MethodGet,
+			response: testResponse(http.StatusNotFound, nil),
+		},
+		{
+			name:           "rate limited post",
+			method:         http.MethodPost,
+			response:       testResponse(http.StatusTooManyRequests, nil),
+			expectedReason: retryReasonRateLimited,
+		},
+		{
+			name:           "unavailable put",
+			method:         http.MethodPut,
+			response:       testResponse(http.StatusServiceUnavailable, nil),
+			expectedReason: retryReasonUnavailable,
+		},
+		{
+			name:     "unavailable post",
+			method:   http.MethodPost,
+			response: testResponse(http.StatusBadGateway, nil),
+		},
+		{
+			name:           "connection reset get",
+			method:         http.MethodGet,
+			err:            syscall.ECONNRESET,
+			expectedReason: retryReasonConnection,
+		},
+		{
+			name:   "connection reset post",
+			method: http.MethodPost,
+			err:    syscall.ECONNRESET,
+		},
+		{
+			name:   "cancelled",
+			method: http.MethodGet,
+			err:    context.Canceled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedReason, retryReason(tt.method, tt.response, tt.err))
+		})
+	}
+}
+
+func TestRetryPolicy_delay(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 4, InitialBackoff: time.Second, MaxBackoff: 10 * time.Second}
+
+	// backoff
+	delay, ok := policy.delay(3, testResponse(http.StatusServiceUnavailable, nil))
+	assert.True(t, ok)
+	assert.GreaterOrEqual(t, int64(delay), int64(2*time.Second))
+	assert.LessOrEqual(t, int64(delay), int64(4*time.Second))
+
+	// capped backoff
+	delay, ok = policy.delay(10, testResponse(http.StatusServiceUnavailable, nil))
+	assert.True(t, ok)
+	assert.LessOrEqual(t, int64(delay), int64(10*time.Second))
+
+	// Retry-After
+	delay, ok = policy.delay(1, testResponse(http.StatusTooManyRequests, http.Header{"Retry-After": {"3"}}))
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, delay)
+
+	// Retry-After longer than the maximum backoff
+	_, ok = policy.delay(1, testResponse(http.StatusTooManyRequests, http.Header{"Retry-After": {"60"}}))
+	assert.False(t, ok)
+}
+
+func TestSecureAccessCloudClientImpl_do(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		statusCodes      []int
+		expectedAttempts int
+		expectedStatus   int
+	}{
+		{
+			name:             "retried until success",
+			method:           http.MethodGet,
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			expectedAttempts: 3,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "retried until the maximum attempts",
+			method:           http.MethodDelete,
+			statusCodes:      []int{http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusOK},
+			expectedAttempts: 3,
+			expectedStatus:   http.StatusGatewayTimeout,
+		},
+		{
+			name:             "post is not retried when unavailable",
+			method:           http.MethodPost,
+			statusCodes:      []int{http.StatusServiceUnavailable, http.StatusCreated},
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusServiceUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			attempts := 0
+			client := &SecureAccessCloudClientImpl{
+				Setting: &SecureAccessCloudSettings{TenantDomain: "tenant.example.com"},
+				Client: resty.New().SetTransport(roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+					statusCode := tt.statusCodes[attempts]
+					attempts++
+					return &http.Response{StatusCode: statusCode, Header: http.Header{}, Body: ioutil.NopCloser(strings.NewReader("{}"))}, nil
+				})),
+				Retry: RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond},
+				token: &oauth2.Token{AccessToken: "token", Expiry: time.Now().Add(time.Hour)},
+			}
+
+			// when
+			response, err := client.do(context.Background(), tt.method, "https://api.tenant.example.com/v2/sites", nil)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, response.StatusCode())
+			assert.Equal(t, tt.expectedAttempts, attempts)
+		})
+	}
+}
+
+func TestSecureAccessCloudClientImpl_do_CancelledWhileWaiting(t *testing.T) {
+	// given
+	client := &SecureAccessCloudClientImpl{
+		Setting: &SecureAccessCloudSettings{TenantDomain: "tenant.example.com"},
+		Client: resty.New().SetTransport(roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"5"}}, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+		})),
+		Retry: RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Minute},
+		token: &oauth2.Token{AccessToken: "token", Expiry: time.Now().Add(time.Hour)},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// when
+	_, err := client.do(ctx, http.MethodGet, "https://api.tenant.example.com/v2/sites", nil)
+
+	// then
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -1,29 +1,39 @@
 package sac
 
 import (
+	"context"
+
 	"bitbucket.org/accezz-io/sac-operator/model"
 	"bitbucket.org/accezz-io/sac-operator/service/sac/dto"
 )
 
 //go:generate mockery --name=SecureAccessCloudClient --inpackage --case=underscore --output=mockSecureAccessCloudClientInterface
 type SecureAccessCloudClient interface {
-	CreateApplication(applicationDTO *dto.ApplicationDTO) (*dto.ApplicationDTO, error)
-	UpdateApplication(applicationDTO *dto.ApplicationDTO) (*dto.ApplicationDTO, error)
-	FindApplicationByName(name string) (*dto.ApplicationDTO, error)
-	FindApplicationByID(id string) (*dto.ApplicationDTO, error)
-	DeleteApplication(id string) error
+	CreateApplication(ctx context.Context, applicationDTO *dto.ApplicationDTO) (*dto.ApplicationDTO, error)
+	UpdateApplication(ctx context.Context, applicationDTO *dto.ApplicationDTO) (*dto.ApplicationDTO, error)
+	FindApplicationByName(ctx context.Context, name string) (*dto.ApplicationDTO, error)
+	FindApplicationByID(ctx context.Context, id string) (*dto.ApplicationDTO, error)
+	ListApplications(ctx context.Context) ([]dto.ApplicationDTO, error)
+	DeleteApplication(ctx context.Context, id string) error
 
-	FindPolicyByName(name string) (dto.PolicyDTO, error)
-	FindPoliciesByNames(name []string) ([]dto.PolicyDTO, error)
-	UpdatePolicies(applicationId string, applicationType model.ApplicationType, policies []string) error
+	FindPolicyByName(ctx context.Context, policyType model.PolicyType, name string) (dto.PolicyDTO, error)
+	FindPoliciesByNames(ctx context.Context, references []model.PolicyReference) ([]dto.PolicyDTO, error)
+	UpdatePolicies(ctx context.Context, applicationId string, applicationType model.ApplicationType, policies []string) error
+	FindPolicyByID(ctx context.Context, id string) (*dto.PolicyDTO, error)
+	ListPolicies(ctx context.Context) ([]dto.PolicyDTO, error)
+	CreatePolicy(ctx context.Context, policyDTO *dto.PolicyDTO) (*dto.PolicyDTO, error)
+	UpdatePolicy(ctx context.Context, policyDTO *dto.PolicyDTO) (*dto.PolicyDTO, error)
+	DeletePolicy(ctx context.Context, id string) error
 
-	FindSiteByName(name string) (*dto.SiteDTO, error)
-	CreateSite(siteDTO *dto.SiteDTO) (*dto.SiteDTO, error)
-	DeleteSite(id string) error
-	BindApplicationToSite(applicationId string, siteId string) error
+	FindSiteByName(ctx context.Context, name string) (*dto.SiteDTO, error)
+	FindSiteByID(ctx context.Context, id string) (*dto.SiteDTO, error)
+	ListSites(ctx context.Context) ([]dto.SiteDTO, error)
+	CreateSite(ctx context.Context, siteDTO *dto.SiteDTO) (*dto.SiteDTO, error)
+	DeleteSite(ctx context.Context, id string) error
+	BindApplicationToSite(ctx context.Context, applicationId string, siteId string) error
 
-	CreateConnector(siteDTO *dto.SiteDTO, connectorName string) (*dto.ConnectorObjects, error)
-	ListConnectorsBySite(siteName string) ([]string, error)
-	DeleteConnector(connectorID string) error
-	GetConnectorDeploymentCommand(connectorID string) (*dto.ConnectorDeploymentCommand, error)
+	CreateConnector(ctx context.Context, siteDTO *dto.SiteDTO, connectorName string) (*dto.ConnectorObjects, error)
+	ListConnectorsBySite(ctx context.Context, siteName string) ([]string, error)
+	DeleteConnector(ctx context.Context, connectorID string) error
+	GetConnectorDeploymentCommand(ctx context.Context, connectorID string) (*dto.ConnectorDeploymentCommand, error)
 }
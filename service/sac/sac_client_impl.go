@@ -7,41 +7,45 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"bitbucket.org/accezz-io/sac-operator/model"
 	"bitbucket.org/accezz-io/sac-operator/service/sac/dto"
-	"golang.org/x/oauth2/clientcredentials"
+	"golang.org/x/oauth2"
 	"gopkg.in/resty.v1"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
-var ErrorPermissionDenied = fmt.Errorf("permission denied")
-var ErrorNotFound = fmt.Errorf("not found")
-var ErrConflict = fmt.Errorf("already exist")
-
-func IsConflict(err error) bool {
-	return err == ErrConflict
-}
+// listPageSize is the number of objects requested per page when listing Secure-Access-Cloud objects.
+const listPageSize = 100
 
 type SecureAccessCloudClientImpl struct {
 	Setting *SecureAccessCloudSettings
 	Client  *resty.Client
+	Retry   RetryPolicy
+	// Limiter limits the rate of the requests to the tenant, nil when the requests are not limited.
+	Limiter *RateLimiter
+	mu      sync.Mutex
+	token   *oauth2.Token
 }
 
 func NewSecureAccessCloudClientImpl(setting *SecureAccessCloudSettings) SecureAccessCloudClient {
-	return &SecureAccessCloudClientImpl{Client: nil, Setting: setting}
+	return &SecureAccessCloudClientImpl{Client: nil, Setting: setting, Retry: DefaultRetryPolicy}
 }
 
 // ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 // Application API
 // ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (s *SecureAccessCloudClientImpl) CreateApplication(applicationDTO *dto.ApplicationDTO) (*dto.ApplicationDTO, error) {
+func (s *SecureAccessCloudClientImpl) CreateApplication(ctx context.Context, applicationDTO *dto.ApplicationDTO) (*dto.ApplicationDTO, error) {
 	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/applications/"
 
 	var createdApplicationDTO dto.ApplicationDTO
 
-	err := s.performModifyRequest(http.MethodPost, endpoint, applicationDTO, &createdApplicationDTO)
+	err := s.performModifyRequest(ctx, http.MethodPost, endpoint, applicationDTO, &createdApplicationDTO)
 	if err != nil {
 		return nil, err
 	}
@@ -49,12 +53,12 @@ func (s *SecureAccessCloudClientImpl) CreateApplication(applicationDTO *dto.Appl
 	return &createdApplicationDTO, nil
 }
 
-func (s *SecureAccessCloudClientImpl) UpdateApplication(applicationDTO *dto.ApplicationDTO) (*dto.ApplicationDTO, error) {
+func (s *SecureAccessCloudClientImpl) UpdateApplication(ctx context.Context, applicationDTO *dto.ApplicationDTO) (*dto.ApplicationDTO, error) {
 	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/applications/" + applicationDTO.ID
 
 	var createdApplicationDTO dto.ApplicationDTO
 
-	err := s.performModifyRequest(http.MethodPut, endpoint, applicationDTO, createdApplicationDTO)
+	err := s.performModifyRequest(ctx, http.MethodPut, endpoint, applicationDTO, createdApplicationDTO)
 	if err != nil {
 		return nil, err
 	}
@@ -62,11 +66,11 @@ func (s *SecureAccessCloudClientImpl) UpdateApplication(applicationDTO *dto.Appl
 	return &createdApplicationDTO, nil
 }
 
-func (s *SecureAccessCloudClientImpl) FindApplicationByID(id string) (*dto.ApplicationDTO, error) {
+func (s *SecureAccessCloudClientImpl) FindApplicationByID(ctx context.Context, id string) (*dto.ApplicationDTO, error) {
 	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/applications/" + id
 
 	var application dto.ApplicationDTO
-	err := s.performGetRequest(endpoint, &application)
+	err := s.performGetRequest(ctx, endpoint, &application)
 
 	if err != nil {
 		return &dto.ApplicationDTO{}, err
@@ -75,38 +79,53 @@ func (s *SecureAccessCloudClientImpl) FindApplicationByID(id string) (*dto.Appli
 	return &application, nil
 }
 
-func (s *SecureAccessCloudClientImpl) FindApplicationByName(name string) (*dto.ApplicationDTO, error) {
-	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/applications" + "?filter=" + url.QueryEscape(name)
-
-	var applications dto.ApplicationPageDTO
-	err := s.performGetRequest(endpoint, &applications)
-
+func (s *SecureAccessCloudClientImpl) FindApplicationByName(ctx context.Context, name string) (*dto.ApplicationDTO, error) {
+	applications, err := s.listApplications(ctx, name)
 	if err != nil {
 		return &dto.ApplicationDTO{}, err
 	}
 
-	if applications.NumberOfElements == 0 {
-		return &dto.ApplicationDTO{}, ErrorNotFound
+	index, err := exactNameMatch("applications", name, len(applications), func(i int) string { return applications[i].Name })
+	if err != nil {
+		return &dto.ApplicationDTO{}, err
 	}
 
-	// Return the first policy if more than one found
-	return &applications.Content[0], nil
+	return &applications[index], nil
 }
 
-func (s *SecureAccessCloudClientImpl) DeleteApplication(id string) error {
-	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/applications/" + id
+// ListApplications returns all the applications of the tenant, walking all the pages of the listing.
+func (s *SecureAccessCloudClientImpl) ListApplications(ctx context.Context) ([]dto.ApplicationDTO, error) {
+	return s.listApplications(ctx, "")
+}
 
-	// 1. Get Authorization Token
-	client := s.getClient()
+// listApplications returns the applications matching the filter, walking all the pages of the listing.
+func (s *SecureAccessCloudClientImpl) listApplications(ctx context.Context, filter string) ([]dto.ApplicationDTO, error) {
+	var applications []dto.ApplicationDTO
+
+	for page := 0; ; page++ {
+		var pageDTO dto.ApplicationPageDTO
+		if err := s.performGetRequest(ctx, s.pageEndpoint("/v2/applications", filter, page), &pageDTO); err != nil {
+			return nil, err
+		}
 
-	// 2. Perform the GET request
-	response, err := client.NewRequest().Delete(endpoint)
+		applications = append(applications, pageDTO.Content...)
+		if isLastPage(page, len(pageDTO.Content), pageDTO.TotalPages, pageDTO.Last) {
+			return applications, nil
+		}
+	}
+}
+
+func (s *SecureAccessCloudClientImpl) DeleteApplication(ctx context.Context, id string) error {
+	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/applications/" + id
+
+	// 1. Perform the DELETE request
+	response, err := s.do(ctx, http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return err
 	}
 
 	if response.StatusCode() != http.StatusOK && response.StatusCode() != http.StatusNoContent {
-		return fmt.Errorf("failed with status-code: %d and body: %s", response.StatusCode(), response.String())
+		return newAPIError(http.MethodDelete, endpoint, response)
 	}
 
 	return nil
@@ -116,29 +135,56 @@ func (s *SecureAccessCloudClientImpl) DeleteApplication(id string) error {
 // Policy API
 // ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (s *SecureAccessCloudClientImpl) FindPolicyByName(name string) (dto.PolicyDTO, error) {
-	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/policies" + "?filter=" + url.QueryEscape(name)
+// FindPolicyByName returns the only policy of the given type having the name, an access policy and an activity policy
+// may have the same name.
+func (s *SecureAccessCloudClientImpl) FindPolicyByName(ctx context.Context, policyType model.PolicyType, name string) (dto.PolicyDTO, error) {
+	found, err := s.listPolicies(ctx, name)
+	if err != nil {
+		return dto.PolicyDTO{}, err
+	}
 
-	var policies dto.PoliciesPageDTO
-	err := s.performGetRequest(endpoint, &policies)
+	var policies []dto.PolicyDTO
+	for i := range found {
+		if found[i].Type == policyType.String() {
+			policies = append(policies, found[i])
+		}
+	}
 
+	index, err := exactNameMatch(strings.ToLower(policyType.String())+" policies", name, len(policies), func(i int) string { return policies[i].Name })
 	if err != nil {
 		return dto.PolicyDTO{}, err
 	}
 
-	if policies.NumberOfElements == 0 {
-		return dto.PolicyDTO{}, ErrorNotFound
-	}
+	return policies[index], nil
+}
+
+// ListPolicies returns all the policies of the tenant, walking all the pages of the listing.
+func (s *SecureAccessCloudClientImpl) ListPolicies(ctx context.Context) ([]dto.PolicyDTO, error) {
+	return s.listPolicies(ctx, "")
+}
+
+// listPolicies returns the policies matching the filter, walking all the pages of the listing.
+func (s *SecureAccessCloudClientImpl) listPolicies(ctx context.Context, filter string) ([]dto.PolicyDTO, error) {
+	var policies []dto.PolicyDTO
+
+	for page := 0; ; page++ {
+		var pageDTO dto.PoliciesPageDTO
+		if err := s.performGetRequest(ctx, s.pageEndpoint("/v2/policies", filter, page), &pageDTO); err != nil {
+			return nil, err
+		}
 
-	// Return the first policy if more than one found
-	return policies.Content[0], nil
+		policies = append(policies, pageDTO.Content...)
+		if isLastPage(page, len(pageDTO.Content), pageDTO.TotalPages, pageDTO.Last) {
+			return policies, nil
+		}
+	}
 }
 
-func (s *SecureAccessCloudClientImpl) FindPoliciesByNames(names []string) ([]dto.PolicyDTO, error) {
+func (s *SecureAccessCloudClientImpl) FindPoliciesByNames(ctx context.Context, references []model.PolicyReference) ([]dto.PolicyDTO, error) {
 	var results []dto.PolicyDTO
 
-	for _, name := range names {
-		policyDTO, err := s.FindPolicyByName(name)
+	for _, reference := range references {
+		policyDTO, err := s.FindPolicyByName(ctx, reference.Type, reference.Name)
 		if err != nil {
 			return results, err
 		}
@@ -149,7 +195,7 @@ func (s *SecureAccessCloudClientImpl) FindPoliciesByNames(names []string) ([]dto
 	return results, nil
 }
 
-func (s *SecureAccessCloudClientImpl) UpdatePolicies(applicationId string, applicationType model.ApplicationType, policies []string) error {
+func (s *SecureAccessCloudClientImpl) UpdatePolicies(ctx context.Context, applicationId string, applicationType model.ApplicationType, policies []string) error {
 	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/policies/by-app-id/" + applicationId
 
 	applicationToPoliciesBindingRequest := applicationToPoliciesBinding{
@@ -157,7 +203,7 @@ func (s *SecureAccessCloudClientImpl) UpdatePolicies(applicationId string, appli
 		PolicyIDs:       policies,
 	}
 
-	err := s.performModifyRequest(http.MethodPut, endpoint, applicationToPoliciesBindingRequest, nil)
+	err := s.performModifyRequest(ctx, http.MethodPut, endpoint, applicationToPoliciesBindingRequest, nil)
 	if err != nil {
 		return err
 	}
@@ -165,15 +211,70 @@ func (s *SecureAccessCloudClientImpl) UpdatePolicies(applicationId string, appli
 	return nil
 }
 
+func (s *SecureAccessCloudClientImpl) FindPolicyByID(ctx context.Context, id string) (*dto.PolicyDTO, error) {
+	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/policies/" + id
+
+	var policy dto.PolicyDTO
+	err := s.performGetRequest(ctx, endpoint, &policy)
+
+	if err != nil {
+		return &dto.PolicyDTO{}, err
+	}
+
+	return &policy, nil
+}
+
+func (s *SecureAccessCloudClientImpl) CreatePolicy(ctx context.Context, policyDTO *dto.PolicyDTO) (*dto.PolicyDTO, error) {
+	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/policies"
+
+	var createdPolicyDTO dto.PolicyDTO
+
+	err := s.performModifyRequest(ctx, http.MethodPost, endpoint, policyDTO, &createdPolicyDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	return &createdPolicyDTO, nil
+}
+
+func (s *SecureAccessCloudClientImpl) UpdatePolicy(ctx context.Context, policyDTO *dto.PolicyDTO) (*dto.PolicyDTO, error) {
+	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/policies/" + policyDTO.ID
+
+	var updatedPolicyDTO dto.PolicyDTO
+
+	err := s.performModifyRequest(ctx, http.MethodPut, endpoint, policyDTO, &updatedPolicyDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedPolicyDTO, nil
+}
+
+func (s *SecureAccessCloudClientImpl) DeletePolicy(ctx context.Context, id string) error {
+	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/policies/" + id
+
+	// 1. Perform the DELETE request
+	response, err := s.do(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode() != http.StatusOK && response.StatusCode() != http.StatusNoContent {
+		return newAPIError(http.MethodDelete, endpoint, response)
+	}
+
+	return nil
+}
+
 // ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 // SiteName API
 // ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (s *SecureAccessCloudClientImpl) CreateSite(siteDTO *dto.SiteDTO) (*dto.SiteDTO, error) {
+func (s *SecureAccessCloudClientImpl) CreateSite(ctx context.Context, siteDTO *dto.SiteDTO) (*dto.SiteDTO, error) {
 	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/sites"
 
 	site := &dto.SiteDTO{}
-	err := s.performPostRequest(endpoint, siteDTO, site)
+	err := s.performPostRequest(ctx, endpoint, siteDTO, site)
 	if err != nil {
 		return &dto.SiteDTO{}, err
 	}
@@ -181,42 +282,72 @@ func (s *SecureAccessCloudClientImpl) CreateSite(siteDTO *dto.SiteDTO) (*dto.Sit
 	return site, nil
 }
 
-func (s *SecureAccessCloudClientImpl) DeleteSite(id string) error {
+func (s *SecureAccessCloudClientImpl) DeleteSite(ctx context.Context, id string) error {
 	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/sites/" + id
 
-	return s.performDeleteRequest(endpoint)
+	return s.performDeleteRequest(ctx, endpoint)
 
 }
 
-func (s *SecureAccessCloudClientImpl) FindSiteByName(name string) (*dto.SiteDTO, error) {
-	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/sites" + "?filter=" + url.QueryEscape(name)
+func (s *SecureAccessCloudClientImpl) FindSiteByName(ctx context.Context, name string) (*dto.SiteDTO, error) {
+	sites, err := s.listSites(ctx, name)
+	if err != nil {
+		return &dto.SiteDTO{}, err
+	}
+
+	index, err := exactNameMatch("sites", name, len(sites), func(i int) string { return sites[i].Name })
+	if err != nil {
+		return &dto.SiteDTO{}, err
+	}
+
+	return &sites[index], nil
+}
 
-	var pageDTO dto.SitePageDTO
+func (s *SecureAccessCloudClientImpl) FindSiteByID(ctx context.Context, id string) (*dto.SiteDTO, error) {
+	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/sites/" + id
 
-	err := s.performGetRequest(endpoint, &pageDTO)
+	var site dto.SiteDTO
+	err := s.performGetRequest(ctx, endpoint, &site)
 
 	if err != nil {
 		return &dto.SiteDTO{}, err
 	}
 
-	if pageDTO.NumberOfElements == 0 {
-		return &dto.SiteDTO{}, ErrorNotFound
-	}
+	return &site, nil
+}
+
+// ListSites returns all the sites of the tenant, walking all the pages of the listing.
+func (s *SecureAccessCloudClientImpl) ListSites(ctx context.Context) ([]dto.SiteDTO, error) {
+	return s.listSites(ctx, "")
+}
 
-	// Return the first policy if more than one found
-	return &pageDTO.Content[0], nil
+// listSites returns the sites matching the filter, walking all the pages of the listing.
+func (s *SecureAccessCloudClientImpl) listSites(ctx context.Context, filter string) ([]dto.SiteDTO, error) {
+	var sites []dto.SiteDTO
+
+	for page := 0; ; page++ {
+		var pageDTO dto.SitePageDTO
+		if err := s.performGetRequest(ctx, s.pageEndpoint("/v2/sites", filter, page), &pageDTO); err != nil {
+			return nil, err
+		}
+
+		sites = append(sites, pageDTO.Content...)
+		if isLastPage(page, len(pageDTO.Content), pageDTO.TotalPages, pageDTO.Last) {
+			return sites, nil
+		}
+	}
 }
 
-func (s *SecureAccessCloudClientImpl) BindApplicationToSite(applicationId string, siteId string) error {
+func (s *SecureAccessCloudClientImpl) BindApplicationToSite(ctx context.Context, applicationId string, siteId string) error {
 	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/applications/" + applicationId + "/site-binding/" + siteId
-	return s.performModifyRequest(http.MethodPut, endpoint, nil, nil)
+	return s.performModifyRequest(ctx, http.MethodPut, endpoint, nil, nil)
 }
 
 // ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 // Connector AP=
 // ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (s *SecureAccessCloudClientImpl) CreateConnector(siteDTO *dto.SiteDTO, connectorName string) (*dto.ConnectorObjects, error) {
+func (s *SecureAccessCloudClientImpl) CreateConnector(ctx context.Context, siteDTO *dto.SiteDTO, connectorName string) (*dto.ConnectorObjects, error) {
 	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/connectors?bind_to_site_id=" + siteDTO.ID
 
 	connector := &dto.ConnectorObjects{
@@ -224,7 +355,7 @@ func (s *SecureAccessCloudClientImpl) CreateConnector(siteDTO *dto.SiteDTO, conn
 		DeploymentType: "docker-compose",
 	}
 
-	err := s.performPostRequest(endpoint, connector, connector)
+	err := s.performPostRequest(ctx, endpoint, connector, connector)
 	if err != nil {
 		return &dto.ConnectorObjects{}, err
 	}
@@ -232,26 +363,26 @@ func (s *SecureAccessCloudClientImpl) CreateConnector(siteDTO *dto.SiteDTO, conn
 	return connector, nil
 }
 
-func (s *SecureAccessCloudClientImpl) ListConnectorsBySite(siteName string) ([]string, error) {
-	site, err := s.FindSiteByName(siteName)
+func (s *SecureAccessCloudClientImpl) ListConnectorsBySite(ctx context.Context, siteName string) ([]string, error) {
+	site, err := s.FindSiteByName(ctx, siteName)
 	if err != nil {
 		return nil, err
 	}
 	return site.Connectors, nil
 }
 
-func (s *SecureAccessCloudClientImpl) DeleteConnector(id string) error {
+func (s *SecureAccessCloudClientImpl) DeleteConnector(ctx context.Context, id string) error {
 	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/connectors/" + id
 
-	return s.performDeleteRequest(endpoint)
+	return s.performDeleteRequest(ctx, endpoint)
 }
 
-func (s *SecureAccessCloudClientImpl) GetConnectorDeploymentCommand(id string) (*dto.ConnectorDeploymentCommand, error) {
+func (s *SecureAccessCloudClientImpl) GetConnectorDeploymentCommand(ctx context.Context, id string) (*dto.ConnectorDeploymentCommand, error) {
 	endpoint := s.Setting.BuildAPIPrefixURL() + "/v2/connectors/" + id + "/command"
 
 	connectorDeploymentCommand := &dto.ConnectorDeploymentCommand{}
 
-	if err := s.performGetRequest(endpoint, &connectorDeploymentCommand); err != nil {
+	if err := s.performGetRequest(ctx, endpoint, &connectorDeploymentCommand); err != nil {
 		return nil, err
 	}
 	return connectorDeploymentCommand, nil
@@ -265,47 +396,164 @@ func (s *SecureAccessCloudClientImpl) GetTenantDomain() string {
 // Private Functions
 // ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// pageEndpoint returns the endpoint of the given page of the listing of the objects matching the filter.
+func (s *SecureAccessCloudClientImpl) pageEndpoint(path string, filter string, page int) string {
+	query := url.Values{}
+	query.Set("filter", filter)
+	query.Set("page", strconv.Itoa(page))
+	query.Set("size", strconv.Itoa(listPageSize))
+	return s.Setting.BuildAPIPrefixURL() + path + "?" + query.Encode()
+}
+
+// isLastPage returns whether the given page is the last page of a listing. An empty page ends the listing as well, in
+// case the total number of pages is not returned.
+func isLastPage(page int, numberOfElements int, totalPages int, last bool) bool {
+	return last || numberOfElements == 0 || (totalPages > 0 && page+1 >= totalPages)
+}
+
+// exactNameMatch returns the index of the only listed object having exactly the given name. The listing filter is a
+// fuzzy match, e.g. filtering by "prod" lists "prod-eu" too, so only the objects with the exact same name are matched.
+func exactNameMatch(kind string, name string, count int, nameAt func(i int) string) (int, error) {
+	index, matches := -1, 0
+	for i := 0; i < count; i++ {
+		if nameAt(i) == name {
+			index = i
+			matches++
+		}
+	}
+
+	switch matches {
+	case 0:
+		return -1, ErrorNotFound
+	case 1:
+		return index, nil
+	default:
+		return -1, fmt.Errorf("%w: %d %s are named %s", ErrorAmbiguousName, matches, kind, name)
+	}
+}
+
 func (s *SecureAccessCloudClientImpl) getClient() *resty.Client {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	if s.Client != nil {
 		return s.Client
 	}
 
-	cfg := clientcredentials.Config{
-		ClientID:     s.Setting.ClientID,
-		ClientSecret: s.Setting.ClientSecret,
-		TokenURL:     s.Setting.BuildOAuthTokenURL(),
-		Scopes:       []string{},
-	}
-
-	oauthClient := cfg.Client(context.Background())
 	client := resty.New().SetRetryCount(0).SetTimeout(1 * time.Minute)
-	// http://godoc.org/golang.org/x/oauth2 implements `httpRoundTripper` interface
-	// Set the oauthClient transport
-	client.SetTransport(oauthClient.Transport)
+	client.OnAfterResponse(func(c *resty.Client, response *resty.Response) error {
+		if response.StatusCode() == http.StatusUnauthorized {
+			s.resetToken(response.Request.Token)
+		}
+		return nil
+	})
 
 	s.Client = client
 	return s.Client
 }
 
-func (s *SecureAccessCloudClientImpl) performGetRequest(endpoint string, obj interface{}) error {
-	// 1. Get Authorization Token
-	client := s.getClient()
+// newRequest returns a request bound to the given context and authorized with the access token of the client
+// credentials. A missing or expired token is obtained with the same context, so the cancellation and the deadline of
+// the caller also apply to the token request.
+func (s *SecureAccessCloudClientImpl) newRequest(ctx context.Context) (*resty.Request, error) {
+	token, err := s.getToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getClient().NewRequest().SetContext(ctx).SetAuthToken(token.AccessToken), nil
+}
+
+func (s *SecureAccessCloudClientImpl) getToken(ctx context.Context) (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Valid() {
+		return s.token, nil
+	}
 
-	// 2. Perform the GET request
-	response, err := client.NewRequest().Get(endpoint)
+	token, err := s.Setting.buildOAuthConfig().Token(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if response.StatusCode() == http.StatusNotFound {
-		return ErrorNotFound
+	s.token = token
+	return s.token, nil
+}
+
+// resetToken drops the cached access token, so the next request obtains a new token. It is called when the token is
+// rejected, e.g. after the client credentials were rotated.
+func (s *SecureAccessCloudClientImpl) resetToken(accessToken string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && s.token.AccessToken == accessToken {
+		s.token = nil
+	}
+}
+
+// do performs the request, retrying the attempts which failed with a retryable error according to the retry policy.
+// Every attempt is made with a new request, so a token refreshed after a 401 is used, and the waits between the
+// attempts end with the context of the caller.
+func (s *SecureAccessCloudClientImpl) do(ctx context.Context, method string, endpoint string, prepare func(request *resty.Request)) (*resty.Response, error) {
+	log := ctrllog.FromContext(ctx).WithValues("method", method, "endpoint", endpoint)
+	priority := requestPriority(ctx, method)
+
+	for attempt := 1; ; attempt++ {
+		if err := s.Limiter.Wait(ctx, priority); err != nil {
+			if attempt > 1 {
+				requestAttempts.WithLabelValues(method).Observe(float64(attempt - 1))
+			}
+			return nil, err
+		}
+
+		request, err := s.newRequest(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if prepare != nil {
+			prepare(request)
+		}
+
+		response, err := request.Execute(method, endpoint)
+
+		if reason := retryReason(method, response, err); reason != "" && attempt < s.Retry.MaxAttempts {
+			if delay, ok := s.Retry.delay(attempt, response); ok {
+				requestRetries.WithLabelValues(method, reason).Inc()
+				log.V(1).Info("retrying Secure-Access-Cloud request", "attempt", attempt, "reason", reason, "delay", delay)
+
+				timer := time.NewTimer(delay)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					requestAttempts.WithLabelValues(method).Observe(float64(attempt))
+					return nil, ctx.Err()
+				case <-timer.C:
+					continue
+				}
+			}
+		}
+
+		requestAttempts.WithLabelValues(method).Observe(float64(attempt))
+		if attempt > 1 {
+			log.Info("Secure-Access-Cloud request completed after retries", "attempts", attempt, "error", err)
+		}
+		return response, err
+	}
+}
+
+func (s *SecureAccessCloudClientImpl) performGetRequest(ctx context.Context, endpoint string, obj interface{}) error {
+	// 1. Perform the GET request
+	response, err := s.do(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
 	}
 
 	if response.StatusCode() != http.StatusOK {
-		return fmt.Errorf("failed with status-code: %d and body: %s", response.StatusCode(), response.String())
+		return newAPIError(http.MethodGet, endpoint, response)
 	}
 
-	// 3. Convert to Commit model
+	// 2. Convert to Commit model
 	err = json.Unmarshal(response.Body(), &obj)
 	if err != nil {
 		return err
@@ -314,45 +562,37 @@ func (s *SecureAccessCloudClientImpl) performGetRequest(endpoint string, obj int
 	return nil
 }
 
-func (s *SecureAccessCloudClientImpl) performModifyRequest(method string, endpoint string, requestObj interface{}, responseObj interface{}) error {
-	// 1. Get Authorization Token
-	client := s.getClient()
-
-	// 2. Perform the request
-	request := client.NewRequest()
-	var response *resty.Response
-	var err error
+func (s *SecureAccessCloudClientImpl) performModifyRequest(ctx context.Context, method string, endpoint string, requestObj interface{}, responseObj interface{}) error {
+	if method != http.MethodPost && method != http.MethodPut {
+		return errors.New("unsupported http method: " + method)
+	}
 
-	// 2.1. Marshal the request
+	// 1. Marshal the request
+	var body []byte
 	if requestObj != nil {
-		body, err := json.Marshal(requestObj)
+		var err error
+		body, err = json.Marshal(requestObj)
 		if err != nil {
 			return err
 		}
-
-		request = request.SetBody(body)
-
-	}
-	request.SetHeader("Content-Type", "application/json")
-
-	switch method {
-	case http.MethodPost:
-		response, err = request.Post(endpoint)
-	case http.MethodPut:
-		response, err = request.Put(endpoint)
-	default:
-		return errors.New("unsupported http method: " + method)
 	}
 
-	if response.StatusCode() == http.StatusNotFound {
-		return ErrorNotFound
+	// 2. Perform the request
+	response, err := s.do(ctx, method, endpoint, func(request *resty.Request) {
+		if body != nil {
+			request.SetBody(body)
+		}
+		request.SetHeader("Content-Type", "application/json")
+	})
+	if err != nil {
+		return err
 	}
 
 	if !isSuccess(response.StatusCode()) {
-		return fmt.Errorf("failed with status-code: %d and body: %s", response.StatusCode(), response.String())
+		return newAPIError(method, endpoint, response)
 	}
 
-	// 4. Unmarshal response body
+	// 3. Unmarshal response body
 	if responseObj != nil {
 		err = json.Unmarshal(response.Body(), &responseObj)
 		if err != nil {
@@ -368,25 +608,20 @@ type applicationToPoliciesBinding struct {
 	PolicyIDs       []string
 }
 
-func (s *SecureAccessCloudClientImpl) performPostRequest(endpoint string, body, obj interface{}) error {
-	// 1. Get Authorization Token
-	client := s.getClient()
-
-	// 2. Perform the POST request
-	response, err := client.NewRequest().SetBody(body).Post(endpoint)
+func (s *SecureAccessCloudClientImpl) performPostRequest(ctx context.Context, endpoint string, body, obj interface{}) error {
+	// 1. Perform the POST request
+	response, err := s.do(ctx, http.MethodPost, endpoint, func(request *resty.Request) {
+		request.SetBody(body)
+	})
 	if err != nil {
 		return err
 	}
 
-	if response.StatusCode() == http.StatusConflict {
-		return ErrConflict
-	}
-
 	if response.StatusCode() != http.StatusCreated {
-		return fmt.Errorf("failed with status-code: %d and body: %s", response.StatusCode(), response.String())
+		return newAPIError(http.MethodPost, endpoint, response)
 	}
 
-	// 3. Convert to Commit model
+	// 2. Convert to Commit model
 	err = json.Unmarshal(response.Body(), obj)
 	if err != nil {
 		return err
@@ -395,18 +630,15 @@ func (s *SecureAccessCloudClientImpl) performPostRequest(endpoint string, body,
 	return nil
 }
 
-func (s *SecureAccessCloudClientImpl) performDeleteRequest(endpoint string) error {
-	// 1. Get Authorization Token
-	client := s.getClient()
-
-	// 2. Perform the DELETE request
-	response, err := client.NewRequest().Delete(endpoint)
+func (s *SecureAccessCloudClientImpl) performDeleteRequest(ctx context.Context, endpoint string) error {
+	// 1. Perform the DELETE request
+	response, err := s.do(ctx, http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return err
 	}
 
 	if response.StatusCode() != http.StatusNoContent {
-		return fmt.Errorf("failed with status-code: %d and body: %s", response.StatusCode(), response.String())
+		return newAPIError(http.MethodDelete, endpoint, response)
 	}
 
 	return nil
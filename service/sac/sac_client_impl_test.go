@@ -1,8 +1,15 @@
 package sac
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
 	"testing"
+	"time"
 
 	"bitbucket.org/accezz-io/sac-operator/model"
 
@@ -12,6 +19,9 @@ import (
 
 	"bitbucket.org/accezz-io/sac-operator/service/sac/dto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"gopkg.in/resty.v1"
 )
 
 var sacClientTest *SecureAccessCloudClientTest
@@ -44,7 +54,7 @@ func TestFindApplicationByName(t *testing.T) {
 	defer tearDown(t)
 
 	// when
-	result, err := sacClientTest.client.FindApplicationByName("integration-test-application")
+	result, err := sacClientTest.client.FindApplicationByName(context.Background(), "integration-test-application")
 
 	// then
 	assert.NoError(t, err)
@@ -58,7 +68,7 @@ func TestFindApplicationByNameWhenNotFound(t *testing.T) {
 	defer tearDown(t)
 
 	// when
-	_, err := sacClientTest.client.FindApplicationByName("unknown-app")
+	_, err := sacClientTest.client.FindApplicationByName(context.Background(), "unknown-app")
 
 	// then
 	assert.Error(t, err)
@@ -71,7 +81,7 @@ func TestFindSiteByName(t *testing.T) {
 	defer tearDown(t)
 
 	// when
-	result, err := sacClientTest.client.FindSiteByName("integration-test-site")
+	result, err := sacClientTest.client.FindSiteByName(context.Background(), "integration-test-site")
 
 	// then
 	assert.NoError(t, err)
@@ -85,7 +95,7 @@ func TestFindSiteByNameWhenNotFound(t *testing.T) {
 	defer tearDown(t)
 
 	// when
-	_, err := sacClientTest.client.FindSiteByName("unknown-site")
+	_, err := sacClientTest.client.FindSiteByName(context.Background(), "unknown-site")
 
 	// then
 	assert.Error(t, err)
@@ -98,7 +108,7 @@ func TestCreateSite(t *testing.T) {
 	randomSiteName := fmt.Sprintf("create-site-%s", rand.String(4))
 	site := &dto.SiteDTO{}
 	defer func() {
-		err := sacClientTest.client.DeleteSite(site.ID)
+		err := sacClientTest.client.DeleteSite(context.Background(), site.ID)
 		if err != nil {
 			t.Errorf("failed deleteing site %+v", site)
 		}
@@ -106,7 +116,7 @@ func TestCreateSite(t *testing.T) {
 	defer tearDown(t)
 
 	// when
-	site, err := sacClientTest.client.CreateSite(&dto.SiteDTO{
+	site, err := sacClientTest.client.CreateSite(context.Background(), &dto.SiteDTO{
 		ID:   "",
 		Name: randomSiteName,
 	})
@@ -118,7 +128,7 @@ func TestCreateSite(t *testing.T) {
 
 	// when
 	connector := &dto.ConnectorObjects{}
-	connector, err = sacClientTest.client.CreateConnector(site, fmt.Sprintf("connector-%s", rand.String(4)))
+	connector, err = sacClientTest.client.CreateConnector(context.Background(), site, fmt.Sprintf("connector-%s", rand.String(4)))
 	// then
 	assert.NoError(t, err)
 	assert.NotEmpty(t, connector)
@@ -132,7 +142,7 @@ func TestCreateApplication(t *testing.T) {
 	applicationName := fmt.Sprintf("create-application-%s", rand.String(4))
 	application := &dto.ApplicationDTO{}
 	defer func() {
-		err := sacClientTest.client.DeleteApplication(application.ID)
+		err := sacClientTest.client.DeleteApplication(context.Background(), application.ID)
 		if err != nil {
 			t.Errorf("failed deleteing application %+v", application)
 		}
@@ -140,7 +150,7 @@ func TestCreateApplication(t *testing.T) {
 	defer tearDown(t)
 
 	// when
-	application, err := sacClientTest.client.CreateApplication(&dto.ApplicationDTO{
+	application, err := sacClientTest.client.CreateApplication(context.Background(), &dto.ApplicationDTO{
 		Name: applicationName,
 		Type: model.HTTP,
 		ConnectionSettings: dto.ConnectionSettingsDTO{
@@ -167,11 +177,11 @@ func TestBindApplicationToSite(t *testing.T) {
 	application := &dto.ApplicationDTO{}
 	site := &dto.SiteDTO{}
 	defer func() {
-		err := sacClientTest.client.DeleteSite(site.ID)
+		err := sacClientTest.client.DeleteSite(context.Background(), site.ID)
 		if err != nil {
 			t.Errorf("failed deleteing site %+v", application)
 		}
-		err = sacClientTest.client.DeleteApplication(application.ID)
+		err = sacClientTest.client.DeleteApplication(context.Background(), application.ID)
 		if err != nil {
 			t.Errorf("failed deleteing application %+v", application)
 		}
@@ -179,7 +189,7 @@ func TestBindApplicationToSite(t *testing.T) {
 	defer tearDown(t)
 
 	// when
-	application, err := sacClientTest.client.CreateApplication(&dto.ApplicationDTO{
+	application, err := sacClientTest.client.CreateApplication(context.Background(), &dto.ApplicationDTO{
 		Name: applicationName,
 		Type: model.HTTP,
 		ConnectionSettings: dto.ConnectionSettingsDTO{
@@ -187,11 +197,11 @@ func TestBindApplicationToSite(t *testing.T) {
 		},
 	})
 	assert.NoError(t, err)
-	site, err = sacClientTest.client.CreateSite(&dto.SiteDTO{
+	site, err = sacClientTest.client.CreateSite(context.Background(), &dto.SiteDTO{
 		Name: siteName,
 	})
 	assert.NoError(t, err)
-	err = sacClientTest.client.BindApplicationToSite(application.ID, site.ID)
+	err = sacClientTest.client.BindApplicationToSite(context.Background(), application.ID, site.ID)
 	assert.NoError(t, err)
 	// then
 
@@ -204,16 +214,100 @@ func TestSecureAccessCloudClientImpl_GetConnectorDeploymentCommand(t *testing.T)
 	site := &dto.SiteDTO{
 		Name: "integration-test-site",
 	}
-	result, err := sacClientTest.client.FindSiteByName(site.Name)
+	result, err := sacClientTest.client.FindSiteByName(context.Background(), site.Name)
 
 	site.ID = result.ID
 	connectorName := fmt.Sprintf("test-%s", rand.String(4))
-	connector, err := sacClientTest.client.CreateConnector(site, connectorName)
+	connector, err := sacClientTest.client.CreateConnector(context.Background(), site, connectorName)
 	assert.Nil(t, err)
 
-	command, err := sacClientTest.client.GetConnectorDeploymentCommand(connector.ID)
+	command, err := sacClientTest.client.GetConnectorDeploymentCommand(context.Background(), connector.ID)
 	assert.Nil(t, err)
 
 	assert.NotEmpty(t, command)
 
 }
+
+func TestSecureAccessCloudClientImpl_CancelledContext(t *testing.T) {
+	// given
+	client := NewSecureAccessCloudClientImpl(&SecureAccessCloudSettings{ClientID: "id", ClientSecret: "secret", TenantDomain: "tenant.example.com"})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// when
+	_, err := client.FindSiteByName(ctx, "my-site")
+
+	// then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), context.Canceled.Error())
+}
+
+func TestSecureAccessCloudClientImpl_FindSiteByName_Pages(t *testing.T) {
+	pages := [][]string{{"prod-eu", "Prod"}, {"staging", "prod"}, {"prod-us"}}
+	tests := []struct {
+		name          string
+		siteName      string
+		duplicate     bool
+		expectedID    string
+		expectedError error
+	}{
+		{
+			name:       "exact match beyond the first page",
+			siteName:   "prod",
+			expectedID: "prod-id",
+		},
+		{
+			name:       "case-aware match",
+			siteName:   "Prod",
+			expectedID: "Prod-id",
+		},
+		{
+			name:          "only fuzzy matches",
+			siteName:      "pro",
+			expectedError: ErrorNotFound,
+		},
+		{
+			name:          "duplicate names",
+			siteName:      "prod",
+			duplicate:     true,
+			expectedError: ErrorAmbiguousName,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			requestedPages := 0
+			client := &SecureAccessCloudClientImpl{
+				Setting: &SecureAccessCloudSettings{TenantDomain: "tenant.example.com"},
+				Client: resty.New().SetTransport(roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+					page, _ := strconv.Atoi(request.URL.Query().Get("page"))
+					requestedPages++
+
+					pageDTO := dto.SitePageDTO{PageNumber: page, TotalPages: len(pages), Last: page == len(pages)-1}
+					for _, name := range pages[page] {
+						pageDTO.Content = append(pageDTO.Content, dto.SiteDTO{ID: name + "-id", Name: name})
+					}
+					if tt.duplicate && pageDTO.Last {
+						pageDTO.Content = append(pageDTO.Content, dto.SiteDTO{ID: "other-id", Name: "prod"})
+					}
+					body, err := json.Marshal(pageDTO)
+					require.NoError(t, err)
+					return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: ioutil.NopCloser(bytes.NewReader(body))}, nil
+				})),
+				token: &oauth2.Token{AccessToken: "token", Expiry: time.Now().Add(time.Hour)},
+			}
+
+			// when
+			site, err := client.FindSiteByName(context.Background(), tt.siteName)
+
+			// then
+			assert.Equal(t, len(pages), requestedPages)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedID, site.ID)
+		})
+	}
+}
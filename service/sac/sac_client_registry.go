@@ -0,0 +1,168 @@
+package sac
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// DefaultTenant is the name the client of the operator default tenant is registered under. It is used by the
+// objects which do not reference a tenant.
+const DefaultTenant = ""
+
+var ErrorTenantNotFound = fmt.Errorf("tenant not found")
+
+type tenantClient struct {
+	settings   SecureAccessCloudSettings
+	client     SecureAccessCloudClient
+	generation int64
+}
+
+// SecureAccessCloudClientRegistry holds a SecureAccessCloudClient per Secure-Access-Cloud tenant managed by the
+// operator.
+type SecureAccessCloudClientRegistry struct {
+	mu           sync.RWMutex
+	clients      map[string]*tenantClient
+	limiters     map[string]*RateLimiter
+	rateLimit    float64
+	rateBurst    int
+	inventoryTTL time.Duration
+	newClient    func(setting *SecureAccessCloudSettings, limiter *RateLimiter) SecureAccessCloudClient
+}
+
+func NewSecureAccessCloudClientRegistry() *SecureAccessCloudClientRegistry {
+	return &SecureAccessCloudClientRegistry{
+		clients:  map[string]*tenantClient{},
+		limiters: map[string]*RateLimiter{},
+		newClient: func(setting *SecureAccessCloudSettings, limiter *RateLimiter) SecureAccessCloudClient {
+			client := NewSecureAccessCloudClientImpl(setting).(*SecureAccessCloudClientImpl)
+			client.Limiter = limiter
+			return client
+		},
+	}
+}
+
+// SetRateLimit limits the requests to every tenant to limit requests per second, with bursts of up to burst requests.
+// The limit is shared by all the callers of the tenant client, and applies to the clients registered afterwards. A
+// limit of 0 disables the rate limiting.
+func (r *SecureAccessCloudClientRegistry) SetRateLimit(limit float64, burst int) *SecureAccessCloudClientRegistry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.rateLimit = limit
+	r.rateBurst = burst
+	return r
+}
+
+// SetInventoryTTL caches the inventory of every tenant for the given duration, see InventoryCache. The cache applies to
+// the clients registered afterwards. A TTL of 0 disables the cache.
+func (r *SecureAccessCloudClientRegistry) SetInventoryTTL(ttl time.Duration) *SecureAccessCloudClientRegistry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.inventoryTTL = ttl
+	return r
+}
+
+// WarmInventories loads the inventory cache of the registered tenants. It is run once the operator starts, the tenants
+// registered later load their inventory on their first lookup.
+func (r *SecureAccessCloudClientRegistry) WarmInventories(ctx context.Context) error {
+	r.mu.RLock()
+	caches := map[string]*InventoryCache{}
+	for tenant, registered := range r.clients {
+		if cache, ok := registered.client.(*InventoryCache); ok {
+			caches[tenant] = cache
+		}
+	}
+	r.mu.RUnlock()
+
+	for tenant, cache := range caches {
+		if err := cache.Warm(ctx); err != nil {
+			// the inventory is loaded again by the first lookup
+			ctrllog.FromContext(ctx).Error(err, "failed to warm the Secure-Access-Cloud inventory cache", "tenant", tenantMetricLabel(tenant))
+		}
+	}
+	return nil
+}
+
+// limiter returns the rate limiter of the given tenant. The limiter outlives the tenant clients, so that the rate is
+// still limited when the client is recreated after the credentials rotation.
+func (r *SecureAccessCloudClientRegistry) limiter(tenant string) *RateLimiter {
+	if r.rateLimit <= 0 {
+		return nil
+	}
+	limiter, ok := r.limiters[tenant]
+	if !ok {
+		limiter = NewRateLimiter(tenant, r.rateLimit, r.rateBurst)
+		r.limiters[tenant] = limiter
+	}
+	return limiter
+}
+
+// Register registers the client of the given tenant. The registered client is kept as long as the tenant settings
+// do not change, in order to keep reusing its access token. When the settings change (e.g. the client secret was
+// rotated) a new client is created, dropping the token obtained with the previous credentials, and the tenant
+// credentials generation is incremented.
+func (r *SecureAccessCloudClientRegistry) Register(tenant string, settings SecureAccessCloudSettings) SecureAccessCloudClient {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	registered, ok := r.clients[tenant]
+	if ok && registered.settings == settings {
+		return registered.client
+	}
+
+	var generation int64 = 1
+	if ok {
+		generation = registered.generation + 1
+	}
+
+	client := r.newClient(&settings, r.limiter(tenant))
+	if r.inventoryTTL > 0 {
+		client = NewInventoryCache(tenant, client, r.inventoryTTL)
+	}
+	registered = &tenantClient{settings: settings, client: client, generation: generation}
+	r.clients[tenant] = registered
+	credentialsGeneration.WithLabelValues(tenantMetricLabel(tenant)).Set(float64(generation))
+	return registered.client
+}
+
+func (r *SecureAccessCloudClientRegistry) Unregister(tenant string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.clients, tenant)
+	delete(r.limiters, tenant)
+	credentialsGeneration.DeleteLabelValues(tenantMetricLabel(tenant))
+}
+
+// CredentialsGeneration returns the generation of the credentials in use by the client of the given tenant, or 0 when
+// the tenant is not registered.
+func (r *SecureAccessCloudClientRegistry) CredentialsGeneration(tenant string) int64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if registered, ok := r.clients[tenant]; ok {
+		return registered.generation
+	}
+	return 0
+}
+
+// Get returns the client of the given tenant, or the client of the default tenant when no tenant is given.
+func (r *SecureAccessCloudClientRegistry) Get(tenant string) (SecureAccessCloudClient, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	registered, ok := r.clients[tenant]
+	if !ok {
+		if tenant == DefaultTenant {
+			return nil, fmt.Errorf("%w: no tenant is referenced and no default tenant is configured", ErrorTenantNotFound)
+		}
+		return nil, fmt.Errorf("%w: %s", ErrorTenantNotFound, tenant)
+	}
+
+	return registered.client, nil
+}
@@ -0,0 +1,68 @@
+package sac
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecureAccessCloudClientRegistry(t *testing.T) {
+	// given
+	registry := NewSecureAccessCloudClientRegistry()
+	prodSettings := SecureAccessCloudSettings{ClientID: "id", ClientSecret: "secret", TenantDomain: "prod.example.com"}
+	stagingSettings := SecureAccessCloudSettings{ClientID: "id", ClientSecret: "secret", TenantDomain: "staging.example.com"}
+
+	// when
+	defaultClient := registry.Register(DefaultTenant, prodSettings)
+	stagingClient := registry.Register("staging", stagingSettings)
+
+	// then
+	client, err := registry.Get(DefaultTenant)
+	assert.NoError(t, err)
+	assert.Same(t, defaultClient, client)
+
+	client, err = registry.Get("staging")
+	assert.NoError(t, err)
+	assert.Same(t, stagingClient, client)
+
+	_, err = registry.Get("dev")
+	assert.ErrorIs(t, err, ErrorTenantNotFound)
+}
+
+func TestSecureAccessCloudClientRegistry_Register(t *testing.T) {
+	// given
+	registry := NewSecureAccessCloudClientRegistry()
+	settings := SecureAccessCloudSettings{ClientID: "id", ClientSecret: "secret", TenantDomain: "staging.example.com"}
+	client := registry.Register("staging", settings)
+	assert.Equal(t, int64(1), registry.CredentialsGeneration("staging"))
+
+	// when the settings did not change, then the client is reused
+	assert.Same(t, client, registry.Register("staging", settings))
+	assert.Equal(t, int64(1), registry.CredentialsGeneration("staging"))
+
+	// when the settings changed, then a new client is created
+	settings.ClientSecret = "rotated-secret"
+	assert.NotSame(t, client, registry.Register("staging", settings))
+	assert.Equal(t, int64(2), registry.CredentialsGeneration("staging"))
+
+	// when the tenant is unregistered, then it cannot be found
+	registry.Unregister("staging")
+	_, err := registry.Get("staging")
+	assert.ErrorIs(t, err, ErrorTenantNotFound)
+	assert.Equal(t, int64(0), registry.CredentialsGeneration("staging"))
+}
+
+func TestSecureAccessCloudClientRegistry_InventoryTTL(t *testing.T) {
+	// given
+	registry := NewSecureAccessCloudClientRegistry().SetInventoryTTL(time.Minute)
+	settings := SecureAccessCloudSettings{ClientID: "id", ClientSecret: "secret", TenantDomain: "staging.example.com"}
+
+	// when
+	client := registry.Register("staging", settings)
+
+	// then
+	cache, ok := client.(*InventoryCache)
+	assert.True(t, ok)
+	assert.IsType(t, &SecureAccessCloudClientImpl{}, cache.SecureAccessCloudClient)
+}
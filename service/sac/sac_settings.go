@@ -1,5 +1,11 @@
 package sac
 
+import (
+	"context"
+
+	"golang.org/x/oauth2/clientcredentials"
+)
+
 type SecureAccessCloudSettings struct {
 	ClientID     string
 	ClientSecret string
@@ -13,3 +19,18 @@ func (s *SecureAccessCloudSettings) BuildAPIPrefixURL() string {
 func (s *SecureAccessCloudSettings) BuildOAuthTokenURL() string {
 	return s.BuildAPIPrefixURL() + "/v1/oauth/token"
 }
+
+// ValidateCredentials requests an access token from the tenant in order to verify the client credentials.
+func (s *SecureAccessCloudSettings) ValidateCredentials(ctx context.Context) error {
+	_, err := s.buildOAuthConfig().Token(ctx)
+	return err
+}
+
+func (s *SecureAccessCloudSettings) buildOAuthConfig() *clientcredentials.Config {
+	return &clientcredentials.Config{
+		ClientID:     s.ClientID,
+		ClientSecret: s.ClientSecret,
+		TokenURL:     s.BuildOAuthTokenURL(),
+		Scopes:       []string{},
+	}
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
+	"bitbucket.org/accezz-io/sac-operator/utils/typederror"
+)
+
+// sacError returns the error to report when the given action failed in Secure-Access-Cloud, e.g. "create application
+// my-app". The errors which sending the request again cannot fix are wrapped as unrecoverable, and the message tells
+// the user what to do. The other errors are returned as is, wrapped with the action, in order to be retried.
+func sacError(action string, err error) error {
+	var apiError *sac.APIError
+	if !errors.As(err, &apiError) {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, sac.ErrorPermissionDenied):
+		return fmt.Errorf("%w cannot %s: the Secure-Access-Cloud API client lacks the permission, grant it the required role (%s)",
+			typederror.UnrecoverableError, action, apiError)
+	case apiError.IsClientError():
+		return fmt.Errorf("%w cannot %s: Secure-Access-Cloud rejected the request (%s)", typederror.UnrecoverableError, action, apiError)
+	case errors.Is(err, sac.ErrorUnauthorized):
+		// the credentials may be fixed or rotated meanwhile, the request is retried
+		return fmt.Errorf("cannot %s: Secure-Access-Cloud rejected the tenant credentials, verify the client id and secret: %w", action, err)
+	case errors.Is(err, sac.ErrorRateLimited), errors.Is(err, sac.ErrorServerError):
+		return fmt.Errorf("cannot %s: Secure-Access-Cloud is unavailable, will retry: %w", action, err)
+	}
+	return fmt.Errorf("cannot %s: %w", action, err)
+}
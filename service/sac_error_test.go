@@ -0,0 +1,60 @@
+package service
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"bitbucket.org/accezz-io/sac-operator/service/sac"
+	"bitbucket.org/accezz-io/sac-operator/utils/typederror"
+)
+
+func TestSacError(t *testing.T) {
+	tests := []struct {
+		name                string
+		err                 error
+		expectUnrecoverable bool
+		expectedMessage     string
+	}{
+		{
+			name:                "permission denied",
+			err:                 &sac.APIError{Method: http.MethodPost, Endpoint: "/v2/sites", StatusCode: http.StatusForbidden, RequestID: "request-1"},
+			expectUnrecoverable: true,
+			expectedMessage: "UnrecoverableError cannot create site my-site: the Secure-Access-Cloud API client lacks the permission, " +
+				"grant it the required role (POST /v2/sites failed with status-code: 403, request-id: request-1)",
+		},
+		{
+			name:                "bad request",
+			err:                 &sac.APIError{Method: http.MethodPost, Endpoint: "/v2/sites", StatusCode: http.StatusBadRequest, Message: "invalid name"},
+			expectUnrecoverable: true,
+			expectedMessage:     "UnrecoverableError cannot create site my-site: Secure-Access-Cloud rejected the request (POST /v2/sites failed with status-code: 400, message: invalid name)",
+		},
+		{
+			name:            "server error",
+			err:             &sac.APIError{Method: http.MethodPost, Endpoint: "/v2/sites", StatusCode: http.StatusServiceUnavailable},
+			expectedMessage: "cannot create site my-site: Secure-Access-Cloud is unavailable, will retry: POST /v2/sites failed with status-code: 503",
+		},
+		{
+			name:            "unauthorized",
+			err:             &sac.APIError{Method: http.MethodPost, Endpoint: "/v2/sites", StatusCode: http.StatusUnauthorized},
+			expectedMessage: "cannot create site my-site: Secure-Access-Cloud rejected the tenant credentials, verify the client id and secret: POST /v2/sites failed with status-code: 401",
+		},
+		{
+			name:            "not an api error",
+			err:             errors.New("connection refused"),
+			expectedMessage: "connection refused",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			err := sacError("create site my-site", tt.err)
+
+			// then
+			assert.Equal(t, tt.expectUnrecoverable, errors.Is(err, typederror.UnrecoverableError))
+			assert.Equal(t, tt.expectedMessage, err.Error())
+		})
+	}
+}
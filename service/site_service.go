@@ -27,6 +27,8 @@ type SiteReconcileOutput struct {
 	SACSiteID           string
 	HealthyConnectors   []Connector
 	UnHealthyConnectors []Connector
+	// The fields which drifted in Secure-Access-Cloud and were only reported, according to the drift policy
+	Drift []string
 }
 
 type SiteService interface {
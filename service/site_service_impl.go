@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -27,6 +28,7 @@ import (
 type SiteServiceImpl struct {
 	connectorDeployer connector_deployer.ConnectorDeployer
 	sacClient         sac.SecureAccessCloudClient
+	events            EventRecorder
 	log               logr.Logger
 }
 
@@ -37,10 +39,17 @@ func NewSiteServiceImpl(sacClient sac.SecureAccessCloudClient,
 	return &SiteServiceImpl{
 		sacClient:         sacClient,
 		connectorDeployer: connectorDeployer,
+		events:            noopEventRecorder{},
 		log:               log,
 	}
 }
 
+// SetEventRecorder sets the recorder of the events about the reconciled site.
+func (s *SiteServiceImpl) SetEventRecorder(events EventRecorder) *SiteServiceImpl {
+	s.events = events
+	return s
+}
+
 func (s *SiteServiceImpl) Reconcile(ctx context.Context, site *model.Site) (*SiteReconcileOutput, error) {
 	output := &SiteReconcileOutput{}
 
@@ -50,10 +59,13 @@ func (s *SiteServiceImpl) Reconcile(ctx context.Context, site *model.Site) (*Sit
 	}
 
 	if site.ToDelete {
+		// the finalizer waits for the site and its connectors to be deleted
+		ctx = sac.WithPriority(ctx, sac.PriorityHigh)
 		err := s.deleteSiteInSAC(ctx, site, output)
 		return output, err // nothing to reconcile other than deleting the site in SAC
 	}
 
+	siteRecreated := false
 	if site.SACSiteID == "" {
 		err := s.createSiteInSAC(ctx, site, output)
 		if err != nil {
@@ -61,6 +73,11 @@ func (s *SiteServiceImpl) Reconcile(ctx context.Context, site *model.Site) (*Sit
 		}
 	} else {
 		output.SACSiteID = site.SACSiteID
+		recreated, err := s.reconcileSiteDrift(ctx, site, output)
+		if err != nil {
+			return output, err
+		}
+		siteRecreated = recreated
 	}
 
 	connectors, err := s.connectorDeployer.GetConnectorsForSite(ctx, site.Name)
@@ -68,6 +85,16 @@ func (s *SiteServiceImpl) Reconcile(ctx context.Context, site *model.Site) (*Sit
 		return output, err
 	}
 
+	if siteRecreated {
+		// the connectors of the deleted site cannot connect to the new site, they are replaced by new connectors
+		for i := range connectors {
+			if err := s.connectorDeployer.DeleteConnector(ctx, connectors[i].DeploymentName); err != nil {
+				return output, err
+			}
+		}
+		connectors = nil
+	}
+
 	for i := range connectors {
 		switch connectors[i].Status {
 		case connector_deployer.ToDeleteConnectorStatus:
@@ -132,28 +159,77 @@ func (s *SiteServiceImpl) Reconcile(ctx context.Context, site *model.Site) (*Sit
 func (s *SiteServiceImpl) createSiteInSAC(ctx context.Context, site *model.Site, output *SiteReconcileOutput) error {
 
 	sacSite := dto.FromSiteModel(site)
-	siteDto, err := s.sacClient.CreateSite(sacSite)
+	siteDto, err := s.sacClient.CreateSite(ctx, sacSite)
 	if err != nil {
 		if sac.IsConflict(err) {
+			warningEvent(s.events, EventReasonAlreadyExists, "site %s already exists in Secure-Access-Cloud", site.Name)
 			return fmt.Errorf("%w site already exist", typederror.UnrecoverableError)
 		}
-		return err
+		return sacError("create site "+site.Name, err)
 	}
 
 	output.SACSiteID = siteDto.ID
+	normalEvent(s.events, EventReasonSiteCreated, "created site %s in Secure-Access-Cloud", siteDto.ID)
 
 	return nil
 
 }
 
+// reconcileSiteDrift verifies the site still exists in Secure-Access-Cloud with the same id. A site which was deleted, or
+// replaced, outside the cluster is created again unless the drift policy only reports it. It returns whether the site
+// was created again.
+func (s *SiteServiceImpl) reconcileSiteDrift(ctx context.Context, site *model.Site, output *SiteReconcileOutput) (bool, error) {
+
+	foundSite, err := s.sacClient.FindSiteByName(ctx, site.Name)
+	if errors.Is(err, sac.ErrorAmbiguousName) {
+		// the site cannot be told apart from the sites with the same name, its drift is not detected
+		warningEvent(s.events, EventReasonAmbiguousName, "cannot detect site %s drift: %s", site.SACSiteID, err)
+		return false, nil
+	}
+	if err != nil && !errors.Is(err, sac.ErrorNotFound) {
+		return false, sacError("look up site "+site.Name, err)
+	}
+
+	var drift []string
+	switch {
+	case err != nil:
+		drift = []string{model.DriftDeleted}
+	case foundSite.ID != site.SACSiteID:
+		drift, err = dto.Diff(dto.SiteDTO{ID: foundSite.ID, Name: foundSite.Name}, dto.FromSiteModel(site))
+		if err != nil {
+			return false, err
+		}
+	}
+	if len(drift) == 0 {
+		return false, nil
+	}
+
+	if !site.SpecChanged && site.DriftPolicy == model.DriftPolicyReport {
+		output.Drift = drift
+		warningEvent(s.events, EventReasonDriftDetected, "site %s drifted in Secure-Access-Cloud: %s", site.SACSiteID, strings.Join(drift, ", "))
+		return false, nil
+	}
+
+	if len(drift) == 1 && drift[0] == model.DriftDeleted {
+		normalEvent(s.events, EventReasonDriftCorrected, "site %s was deleted in Secure-Access-Cloud, creating it again", site.SACSiteID)
+		return true, s.createSiteInSAC(ctx, site, output)
+	}
+
+	// a site with the same name but another id replaced the site, it is adopted
+	normalEvent(s.events, EventReasonDriftCorrected, "site %s was replaced in Secure-Access-Cloud by site %s", site.SACSiteID, foundSite.ID)
+	output.SACSiteID = foundSite.ID
+	return true, nil
+}
+
 func (s *SiteServiceImpl) deleteSiteInSAC(ctx context.Context, site *model.Site, output *SiteReconcileOutput) error {
 
-	err := s.sacClient.DeleteSite(site.SACSiteID)
-	if err != nil {
-		return err
+	err := s.sacClient.DeleteSite(ctx, site.SACSiteID)
+	if err != nil && !errors.Is(err, sac.ErrorNotFound) {
+		return sacError("delete site "+site.SACSiteID, err)
 	}
 
 	output.Deleted = true
+	normalEvent(s.events, EventReasonSiteDeleted, "deleted site %s from Secure-Access-Cloud", site.SACSiteID)
 
 	return nil
 
@@ -179,16 +255,16 @@ func (s *SiteServiceImpl) reconcilerDanglingConnectorsFromSAC(ctx context.Contex
 	}()
 
 	// removing dangling from sac
-	sacListOfConnectors, err := s.sacClient.ListConnectorsBySite(site.Name)
+	sacListOfConnectors, err := s.sacClient.ListConnectorsBySite(ctx, site.Name)
 	if err != nil {
-		return err
+		return sacError("list the connectors of site "+site.Name, err)
 	}
 
 	toDelete := utils.Subtruct(sacListOfConnectors, podIDs)
 
 	for i := range toDelete {
 		s.log.Info("deleting sac connector", "uuid", toDelete[i])
-		err = s.sacClient.DeleteConnector(toDelete[i])
+		err = s.sacClient.DeleteConnector(ctx, toDelete[i])
 		if err != nil {
 			s.log.Error(err, "could not delete sac connector", "uuid", toDelete[i])
 		}
@@ -202,19 +278,19 @@ func (s *SiteServiceImpl) createConnector(ctx context.Context, site *model.Site)
 
 	connector := &Connector{}
 
-	siteDto, err := s.sacClient.FindSiteByName(site.Name)
+	siteDto, err := s.sacClient.FindSiteByName(ctx, site.Name)
 	if err != nil {
-		return connector, fmt.Errorf("FindSiteByName failed %w", err)
+		return connector, sacError("look up site "+site.Name, err)
 	}
 
-	sacConnector, err := s.sacClient.CreateConnector(siteDto, s.getConnectorName(site))
+	sacConnector, err := s.sacClient.CreateConnector(ctx, siteDto, s.getConnectorName(site))
 	if err != nil {
-		return connector, err
+		return connector, sacError("create a connector of site "+site.Name, err)
 	}
 	connector.SacID = sacConnector.ID
 	s.log.WithValues("id", sacConnector.ID, "name", sacConnector.Name).Info("created connector in sac")
 
-	deployConnectorInput, err := s.getDeployConnectorInputs(sacConnector, site)
+	deployConnectorInput, err := s.getDeployConnectorInputs(ctx, sacConnector, site)
 	if err != nil {
 		return connector, err
 	}
@@ -225,16 +301,17 @@ func (s *SiteServiceImpl) createConnector(ctx context.Context, site *model.Site)
 	}
 	connector.DeploymentName = deploymentName
 	s.log.Info("deployed new connector")
+	normalEvent(s.events, EventReasonConnectorCreated, "created connector %s deployed as %s", sacConnector.ID, deploymentName)
 
 	return connector, nil
 
 }
 
-func (s *SiteServiceImpl) getDeployConnectorInputs(connector *dto.ConnectorObjects, site *model.Site) (*connector_deployer.CreateConnectorInput, error) {
+func (s *SiteServiceImpl) getDeployConnectorInputs(ctx context.Context, connector *dto.ConnectorObjects, site *model.Site) (*connector_deployer.CreateConnectorInput, error) {
 
-	dockerComposeDeploymentCommand, err := s.sacClient.GetConnectorDeploymentCommand(connector.ID)
+	dockerComposeDeploymentCommand, err := s.sacClient.GetConnectorDeploymentCommand(ctx, connector.ID)
 	if err != nil {
-		return nil, err
+		return nil, sacError("get the deployment command of connector "+connector.ID, err)
 	}
 
 	connectorDeploymentArgs, err := s.connectorDeploymentArgsFromCommand(dockerComposeDeploymentCommand)
@@ -257,9 +334,9 @@ func (s *SiteServiceImpl) getConnectorName(site *model.Site) string {
 func (s *SiteServiceImpl) deleteConnector(ctx context.Context, sacID, podName string) error {
 
 	s.log.WithValues("sac connector id", sacID).Info("deleting connector")
-	err := s.sacClient.DeleteConnector(sacID)
-	if err != nil {
-		return err
+	err := s.sacClient.DeleteConnector(ctx, sacID)
+	if err != nil && !errors.Is(err, sac.ErrorNotFound) {
+		return sacError("delete connector "+sacID, err)
 	}
 
 	s.log.WithValues("pod name", podName).Info("deleting pod")
@@ -267,6 +344,7 @@ func (s *SiteServiceImpl) deleteConnector(ctx context.Context, sacID, podName st
 	if err != nil {
 		return err
 	}
+	normalEvent(s.events, EventReasonConnectorDeleted, "deleted connector %s deployed as %s", sacID, podName)
 
 	return nil
 
@@ -46,7 +46,7 @@ func TestSiteServiceImpl_Reconcile(t *testing.T) {
 				siteModel := &model.Site{
 					ToDelete: true,
 				}
-				sacClient.On("DeleteSite", mock.AnythingOfType("string")).Return(nil)
+				sacClient.On("DeleteSite", mock.Anything, mock.AnythingOfType("string")).Return(nil)
 				testLog := ctrl.Log.WithName("test")
 				return NewSiteServiceImpl(sacClient, nil, testLog), siteModel
 			},
@@ -62,7 +62,7 @@ func TestSiteServiceImpl_Reconcile(t *testing.T) {
 				siteModel := &model.Site{
 					ToDelete: true,
 				}
-				sacClient.On("DeleteSite", mock.AnythingOfType("string")).Return(uncategorizedError)
+				sacClient.On("DeleteSite", mock.Anything, mock.AnythingOfType("string")).Return(uncategorizedError)
 				testLog := ctrl.Log.WithName("test")
 				return NewSiteServiceImpl(sacClient, nil, testLog), siteModel
 			},
@@ -81,7 +81,7 @@ func TestSiteServiceImpl_Reconcile(t *testing.T) {
 					Name: "test",
 				}
 				siteDto := dto.FromSiteModel(siteModel)
-				sacClient.On("CreateSite", siteDto).Return(&dto.SiteDTO{
+				sacClient.On("CreateSite", mock.Anything, siteDto).Return(&dto.SiteDTO{
 					ID: "uuid",
 				}, nil)
 				deployer.On("GetConnectorsForSite", ctx, "test").Return([]connector_deployer.Connector{}, nil)
@@ -101,7 +101,7 @@ func TestSiteServiceImpl_Reconcile(t *testing.T) {
 					Name: "test",
 				}
 				siteDto := dto.FromSiteModel(siteModel)
-				sacClient.On("CreateSite", siteDto).Return(&dto.SiteDTO{}, sac.ErrConflict)
+				sacClient.On("CreateSite", mock.Anything, siteDto).Return(&dto.SiteDTO{}, sac.ErrConflict)
 				testLog := ctrl.Log.WithName("test")
 				return NewSiteServiceImpl(sacClient, nil, testLog), siteModel
 			},
@@ -116,7 +116,7 @@ func TestSiteServiceImpl_Reconcile(t *testing.T) {
 					Name: "test",
 				}
 				siteDto := dto.FromSiteModel(siteModel)
-				sacClient.On("CreateSite", siteDto).Return(&dto.SiteDTO{}, uncategorizedError)
+				sacClient.On("CreateSite", mock.Anything, siteDto).Return(&dto.SiteDTO{}, uncategorizedError)
 				testLog := ctrl.Log.WithName("test")
 				return NewSiteServiceImpl(sacClient, nil, testLog), siteModel
 			},
@@ -134,8 +134,10 @@ func TestSiteServiceImpl_Reconcile(t *testing.T) {
 				}
 				connectorList := []connector_deployer.Connector{}
 				deployer.On("GetConnectorsForSite", ctx, "test").Return(connectorList, uncategorizedError)
+				sacClient := &sac.MockSecureAccessCloudClient{}
+				sacClient.On("FindSiteByName", mock.Anything, "test").Return(&dto.SiteDTO{ID: "uuid", Name: "test"}, nil)
 				testLog := ctrl.Log.WithName("test")
-				return NewSiteServiceImpl(nil, deployer, testLog), siteModel
+				return NewSiteServiceImpl(sacClient, deployer, testLog), siteModel
 			},
 			output: &SiteReconcileOutput{
 				SACSiteID: "uuid",
@@ -153,14 +155,61 @@ func TestSiteServiceImpl_Reconcile(t *testing.T) {
 				}
 				connectorList := []connector_deployer.Connector{}
 				deployer.On("GetConnectorsForSite", ctx, "test").Return(connectorList, uncategorizedError)
+				sacClient := &sac.MockSecureAccessCloudClient{}
+				sacClient.On("FindSiteByName", mock.Anything, "test").Return(&dto.SiteDTO{ID: "uuid", Name: "test"}, nil)
 				testLog := ctrl.Log.WithName("test")
-				return NewSiteServiceImpl(nil, deployer, testLog), siteModel
+				return NewSiteServiceImpl(sacClient, deployer, testLog), siteModel
 			},
 			output: &SiteReconcileOutput{
 				SACSiteID: "uuid",
 			},
 			err: uncategorizedError,
 		},
+		{
+			name: "site deleted in sac report drift flow",
+			setupFunc: func() (SiteService, *model.Site) {
+				ctx := context.Background()
+				deployer := &connector_deployer.MockConnectorDeployer{}
+				siteModel := &model.Site{
+					Name:        "test",
+					SACSiteID:   "uuid",
+					DriftPolicy: model.DriftPolicyReport,
+				}
+				deployer.On("GetConnectorsForSite", ctx, "test").Return([]connector_deployer.Connector{}, nil)
+				sacClient := &sac.MockSecureAccessCloudClient{}
+				sacClient.On("FindSiteByName", mock.Anything, "test").Return(&dto.SiteDTO{}, sac.ErrorNotFound)
+				testLog := ctrl.Log.WithName("test")
+				return NewSiteServiceImpl(sacClient, deployer, testLog), siteModel
+			},
+			output: &SiteReconcileOutput{
+				SACSiteID: "uuid",
+				Drift:     []string{model.DriftDeleted},
+			},
+			err: nil,
+		},
+		{
+			name: "site deleted in sac correct drift flow",
+			setupFunc: func() (SiteService, *model.Site) {
+				ctx := context.Background()
+				deployer := &connector_deployer.MockConnectorDeployer{}
+				siteModel := &model.Site{
+					Name:        "test",
+					SACSiteID:   "uuid",
+					DriftPolicy: model.DriftPolicyCorrect,
+				}
+				deployer.On("GetConnectorsForSite", ctx, "test").Return([]connector_deployer.Connector{{DeploymentName: "test-connector"}}, nil)
+				deployer.On("DeleteConnector", ctx, "test-connector").Return(nil)
+				sacClient := &sac.MockSecureAccessCloudClient{}
+				sacClient.On("FindSiteByName", mock.Anything, "test").Return(&dto.SiteDTO{}, sac.ErrorNotFound)
+				sacClient.On("CreateSite", mock.Anything, dto.FromSiteModel(siteModel)).Return(&dto.SiteDTO{ID: "new-uuid"}, nil)
+				testLog := ctrl.Log.WithName("test")
+				return NewSiteServiceImpl(sacClient, deployer, testLog), siteModel
+			},
+			output: &SiteReconcileOutput{
+				SACSiteID: "new-uuid",
+			},
+			err: nil,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {